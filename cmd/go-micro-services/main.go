@@ -10,6 +10,7 @@ import (
 	geosrv "github.com/harlow/go-micro-services/internal/services/geo"
 	profilesrv "github.com/harlow/go-micro-services/internal/services/profile"
 	ratesrv "github.com/harlow/go-micro-services/internal/services/rate"
+	reservationsrv "github.com/harlow/go-micro-services/internal/services/reservation"
 	searchsrv "github.com/harlow/go-micro-services/internal/services/search"
 	"github.com/harlow/go-micro-services/internal/trace"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -23,17 +24,27 @@ type server interface {
 
 func main() {
 	var (
-		port        = flag.Int("port", 8080, "The service port")
-		oteladdr    = flag.String("otel-endpoint", "", "OTLP endpoint (default: jaeger:4317)")
-		jaegeraddr  = flag.String("jaeger", "", "Deprecated alias for -otel-endpoint")
-		profileaddr = flag.String("profileaddr", "profile:8080", "Profile service addr")
-		geoaddr     = flag.String("geoaddr", "geo:8080", "Geo server addr")
-		rateaddr    = flag.String("rateaddr", "rate:8080", "Rate server addr")
-		searchaddr  = flag.String("searchaddr", "search:8080", "Search service addr")
+		port            = flag.Int("port", 8080, "The service port")
+		oteladdr        = flag.String("otel-endpoint", "", "OTLP endpoint (default: jaeger:4317)")
+		jaegeraddr      = flag.String("jaeger", "", "Deprecated alias for -otel-endpoint")
+		profileaddr     = flag.String("profileaddr", "profile:8080", "Profile service addr")
+		geoaddr         = flag.String("geoaddr", "geo:8080", "Geo server addr")
+		rateaddr        = flag.String("rateaddr", "rate:8080", "Rate server addr")
+		searchaddr      = flag.String("searchaddr", "search:8080", "Search service addr")
+		reservationaddr = flag.String("reservationaddr", "reservation:8080", "Reservation service addr")
+		geoJournal      = flag.String("geo-journal", "", "Append-only file persisting geo point updates (disabled when empty)")
+		fxRates         = flag.String("exchange-rates", "", "Exchange rate file for the rate service, reloaded on SIGHUP (embedded rates when empty)")
+		holdTTL         = flag.Duration("hold-ttl", 15*time.Minute, "How long the reservation service holds rooms before they are released unconfirmed (POST /reservations confirms at once)")
+
+		searchTimeout      = flag.Duration("search-timeout", 3*time.Second, "Deadline budget for calls to the search service")
+		profileTimeout     = flag.Duration("profile-timeout", time.Second, "Deadline budget for calls to the profile service")
+		geoTimeout         = flag.Duration("geo-timeout", time.Second, "Deadline budget for calls to the geo service")
+		rateTimeout        = flag.Duration("rate-timeout", time.Second, "Deadline budget for calls to the rate service")
+		reservationTimeout = flag.Duration("reservation-timeout", 500*time.Millisecond, "Deadline budget for each call to the reservation service")
 	)
 	flag.Parse()
 	if flag.NArg() < 1 {
-		log.Fatalf("usage: go-micro-services <frontend|search|profile|geo|rate> [flags]")
+		log.Fatalf("usage: go-micro-services <frontend|search|profile|geo|rate|reservation> [flags]")
 	}
 	cmd := flag.Arg(0)
 	traceEndpoint := "jaeger:4317"
@@ -59,9 +70,15 @@ func main() {
 
 	switch cmd {
 	case "geo":
-		srv = geosrv.New()
+		srv = geosrv.New(*geoJournal)
 	case "rate":
-		srv = ratesrv.New()
+		reservationConn, err := dial(*reservationaddr)
+		if err != nil {
+			log.Fatalf("dial reservation error: %v", err)
+		}
+		srv = ratesrv.New(reservationConn, *reservationTimeout, *fxRates)
+	case "reservation":
+		srv = reservationsrv.New(*holdTTL)
 	case "profile":
 		srv = profilesrv.New()
 	case "search":
@@ -73,7 +90,15 @@ func main() {
 		if err != nil {
 			log.Fatalf("dial rate error: %v", err)
 		}
-		srv = searchsrv.New(geoConn, rateConn)
+		profileConn, err := dial(*profileaddr)
+		if err != nil {
+			log.Fatalf("dial profile error: %v", err)
+		}
+		srv = searchsrv.New(geoConn, rateConn, profileConn, searchsrv.Timeouts{
+			Geo:     *geoTimeout,
+			Rate:    *rateTimeout,
+			Profile: *profileTimeout,
+		})
 	case "frontend":
 		searchConn, err := dial(*searchaddr)
 		if err != nil {
@@ -83,7 +108,20 @@ func main() {
 		if err != nil {
 			log.Fatalf("dial profile error: %v", err)
 		}
-		srv = frontendsrv.New(searchConn, profileConn)
+		rateConn, err := dial(*rateaddr)
+		if err != nil {
+			log.Fatalf("dial rate error: %v", err)
+		}
+		reservationConn, err := dial(*reservationaddr)
+		if err != nil {
+			log.Fatalf("dial reservation error: %v", err)
+		}
+		srv = frontendsrv.New(searchConn, profileConn, rateConn, reservationConn, frontendsrv.Timeouts{
+			Search:      *searchTimeout,
+			Profile:     *profileTimeout,
+			Rate:        *rateTimeout,
+			Reservation: *reservationTimeout,
+		})
 	default:
 		log.Fatalf("unknown cmd: %s", cmd)
 	}
@@ -1,9 +1,14 @@
 // Code generated for package data by go-bindata DO NOT EDIT. (@generated)
 // sources:
+// data/allotments.json
+// data/exchange_rates.json
 // data/geo.json
 // data/hotel_ratings.json
 // data/hotels.json
 // data/inventory.json
+// data/neighborhoods.json
+// data/taxes.json
+// data/translations.json
 package data
 
 import (
@@ -80,7 +85,47 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _dataGeoJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xd4\x3d\x4a\x44\x41\x10\x04\xe0\x7c\x4e\xd1\x4c\xbc\x2e\xfd\x3b\x3d\xfd\x6e\xe0\x19\xc4\x40\x50\x30\x58\xdc\x64\x33\xf1\xee\x82\xb2\xd1\xd6\x4c\xfa\x82\x8f\x9a\xea\xe2\xbd\x34\xa2\xef\x46\x44\xd4\x3f\xaf\xb7\x8f\xcb\xf3\x7b\x3f\xa8\x4b\x3f\xfd\x7f\xbb\xbc\xdd\xfa\x41\x96\xe7\x9c\x23\xef\xdf\xae\x5f\xfd\xa0\x27\x51\x3d\xbb\x88\x36\xa2\x9f\x13\x54\x14\x28\xe1\x40\x61\x8e\xb5\x62\x40\x31\xa8\xa4\xac\x15\x7f\x54\xca\xc6\xa3\x62\x65\xbc\x56\x02\x65\x11\xd4\xcb\xdc\x64\x19\xa8\x5d\x43\x2f\x92\x4d\x2f\x09\x5e\xa4\xae\xcc\x08\xaa\x1c\xb5\x49\x34\x41\xa2\x2c\xcf\x80\xa1\x4a\x72\xac\xad\x42\x56\x7a\x81\xb2\x9d\x67\x65\xd4\xda\x12\x06\x8f\x14\x57\x29\xd4\x39\xab\x66\x6e\x30\xb0\xea\x72\xf5\x54\x34\x83\x28\x66\xdb\x60\x68\xdc\xca\x63\xa2\x64\xec\x93\x65\x53\x99\xa0\x8d\xc7\x14\xc3\xb7\x34\xe7\xb9\xc1\xe0\xd4\x25\x06\x4c\x66\xca\xbe\xc3\xd0\xe2\xc3\x35\xe0\x35\xdd\xcb\x36\xff\x03\x81\xc3\x57\x09\xdc\x99\x7a\xfe\x1d\xa0\xbd\xb6\xdf\x00\x00\x00\xff\xff\xdf\x83\x2d\xb2\xa6\x04\x00\x00")
+var _dataAllotmentsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x94\xc1\x4b\x86\x40\x10\xc5\xef\xfb\x57\x0c\x73\x56\xd8\x19\x77\x2d\xbd\x76\x88\x08\x84\xce\xe1\x4d\xcb\x20\x5b\x30\x6f\xe2\xff\x1e\x9b\x45\x09\xf5\xed\xf0\xe1\x37\x78\x7b\xf8\xf4\xb7\x6f\xde\xce\xa3\x01\x58\x0c\x00\x00\x0e\x61\xee\x5f\xef\x3a\xac\x01\x09\xb3\x4d\x9b\x42\x18\x6f\x42\xd7\x47\xf1\xbe\xb9\xfd\x96\xdf\x5e\x9e\x87\xf9\x1d\x6b\x88\xf6\xf8\x2c\x80\x4f\x53\x18\xe3\x6b\x6c\xc9\xe7\xd6\xe5\x96\x30\x03\x9c\xc3\x8f\xe6\xbf\xb4\xf8\xd1\x68\x26\x86\xf5\xd3\xdf\x1a\x80\x35\x93\x93\x3c\x34\xc7\x82\xb8\x34\x07\x6b\x70\x14\x69\x8e\x42\x65\x32\x65\x1a\xe4\x4a\x05\x84\x6d\x9a\xe4\x5a\x85\x84\xfc\x99\x24\x47\x97\x44\xc0\x51\x69\x70\x08\x3a\x42\x56\x67\x34\x82\x92\x90\xce\x4a\x63\xc1\x74\x88\x55\x50\x2a\x01\x49\xa1\x51\x14\xc9\x96\x77\x2a\x91\x10\x09\x50\xbc\x46\x26\xa2\xca\x96\x17\x09\xc5\xe5\x64\x7f\xa3\xf8\xed\xff\xff\xf8\xc9\xfe\xe1\xe7\xdd\x51\x4e\xfb\x39\x15\xc5\xee\xc6\x98\xd6\x7c\x0c\x00\x5e\x64\xa9\x0b\x92\x08\x00\x00")
+
+func dataAllotmentsJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataAllotmentsJson,
+		"data/allotments.json",
+	)
+}
+
+func dataAllotmentsJson() (*asset, error) {
+	bytes, err := dataAllotmentsJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/allotments.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataExchange_ratesJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xd0\xbf\xaa\xc2\x30\x14\x80\xf1\xbd\x4f\x71\x38\x73\x09\x27\x39\xed\x6d\x9b\xad\xb7\xfe\x43\x97\xa2\x04\x11\x71\x90\xd2\xa1\x83\x11\x62\x1d\x44\xfa\xee\xae\x49\x75\xc8\x03\xfc\xe0\xe3\x3b\x27\x00\x6f\xc0\xee\xe9\x5c\x6f\xbb\x17\x6a\x40\x73\x58\x60\x0a\xe8\xae\x63\x8f\x1a\x64\x0a\x78\x1b\xec\xdd\x19\x3b\x8c\x0f\xd4\xa0\x60\x4a\xbf\xd1\xd2\xec\x3d\x44\xa2\x52\x32\x8f\x93\xeb\xff\x36\x90\x45\x59\x71\x9c\x6c\xea\x20\x54\xf0\x5f\xa6\xe2\x64\x6d\x42\x99\x2b\x59\xc6\xc9\x6d\x7b\xf2\x65\x2e\x05\x17\x73\x49\x3f\x65\xb3\x59\x79\x92\x44\x45\x1c\xf9\x76\x77\xf4\x6b\x49\x30\x15\xd9\x5c\x32\x4c\xc9\x25\xf9\x0c\x00\x47\x3a\x5d\x01\xce\x01\x00\x00")
+
+func dataExchange_ratesJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataExchange_ratesJson,
+		"data/exchange_rates.json",
+	)
+}
+
+func dataExchange_ratesJson() (*asset, error) {
+	bytes, err := dataExchange_ratesJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/exchange_rates.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataGeoJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xd4\x3f\x6a\xab\x31\x10\x04\xf0\x5e\xa7\x58\x54\xfb\x99\xfd\xab\xd5\x7e\x37\x78\x67\x08\x29\x02\x09\xa4\x30\x71\xe3\x2e\xf8\xee\x29\x42\x2a\xcd\xa7\x56\xc5\x8f\x61\x76\xd0\x4b\x23\xfa\x6e\x44\x44\xfd\xf3\xfe\xf8\xb8\xfd\x7f\xef\x07\x75\xe9\x97\xdf\xb7\xdb\xdb\xa3\x1f\x64\x79\xcd\x39\xf2\xef\xed\xfe\xd5\x0f\xfa\x27\xaa\x57\x17\xd1\x46\xf4\xbc\x40\x45\x81\x12\x0e\x14\xe6\x38\x57\x0c\x28\x06\x95\x94\x73\xc5\x57\xa5\x6c\xac\x8a\x95\xf1\xb9\x12\x28\x8b\xac\x8a\xcb\xdc\x64\x19\x40\x19\x06\x14\x96\x4d\x2f\xb9\x2a\xa5\xae\xcc\x08\xaa\x1c\xb5\x49\x34\x41\xa2\x2c\xcf\x40\x96\x94\xe4\x38\xb7\x0a\x59\xe9\x05\xca\x76\x9e\x95\x51\xe7\x96\xf0\x8a\x95\xb8\x4a\x01\x4c\x58\x35\x73\x83\x81\x55\x97\xab\xa7\xae\x98\x55\x14\xb3\x6d\x30\x34\x6e\xe5\x31\x51\x32\xf6\xc9\xb2\xa9\x4c\xd0\xc6\x63\x8a\xe1\x5b\x9a\xf3\xdc\x60\x70\xea\x12\x03\x26\x33\x65\xdf\x61\x68\xf1\xe1\x1a\xf0\x9a\xee\x65\x9b\xff\x40\xe0\xf0\x55\x02\x77\xa6\x9e\xd6\x88\x9e\xed\xb5\xfd\x0c\x00\xdf\x83\x2d\xb2\xa6\x04\x00\x00")
 
 func dataGeoJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -100,7 +145,7 @@ func dataGeoJson() (*asset, error) {
 	return a, nil
 }
 
-var _dataHotel_ratingsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xd1\x31\x0a\xc2\x40\x10\x85\xe1\x7e\x4e\xf1\x98\x3a\x88\x9b\x6c\x92\x75\xaf\x22\x16\x82\x20\xdb\x58\x88\x9d\x78\x77\x0b\x3b\xf9\x07\xa6\x0c\x7c\xbc\x30\xfb\x9f\x4d\x7a\x9b\x24\xf9\xb8\x79\x97\x17\x9f\x7e\x9f\xcf\xeb\x6b\x3c\xee\xde\x55\x0f\xd5\xa4\xcf\xf4\x2f\x67\x90\x1b\xca\x05\xe4\x8a\xb2\x82\xdc\x51\xae\x20\x67\x94\x1b\xc8\x86\x72\x4f\xff\xbd\xa5\x6f\x3f\xa5\x6f\x2f\xc7\xf4\xd3\x17\xaa\x14\xac\x52\xa6\x60\x95\x3a\x2d\x4c\x29\x54\xb0\x4a\xa5\x82\xd5\x30\x95\x5d\xec\x1b\x00\x00\xff\xff\xe7\x05\x73\x79\xaa\x02\x00\x00")
+var _dataHotel_ratingsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xd1\xb1\x0a\x42\x31\x0c\x85\xe1\x3d\x4f\x71\xc8\x7c\x11\xd3\x9b\xde\xd6\xbe\x8a\x38\x08\x82\x74\x71\x10\x37\xf1\xdd\x1d\xdc\xe4\x5c\x38\x63\xe1\xe3\x2f\x49\xce\x06\xbc\x0d\x00\x7c\xde\x7c\xc0\xc3\x97\xdf\xf3\x79\x7d\xcd\xc7\xdd\x07\xf2\x90\x06\x7c\x96\x7f\x59\x88\xdc\xa8\x5c\x89\xac\x54\x26\x91\x8d\xca\x4a\x64\xa1\x72\x23\xb2\x53\xd9\xe4\xdf\xbb\x3c\xfb\x49\x9e\x3d\x8e\xf2\xea\x23\xf4\x6a\xd1\xab\xec\x4e\x2b\xa7\xa9\x57\xab\x5e\xdd\x3d\x95\x5d\xec\x3b\x00\xe7\x05\x73\x79\xaa\x02\x00\x00")
 
 func dataHotel_ratingsJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -120,7 +165,7 @@ func dataHotel_ratingsJson() (*asset, error) {
 	return a, nil
 }
 
-var _dataHotelsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x9a\x4d\x73\xdb\x46\xf2\xc6\xef\xfe\x14\x5d\xba\xfc\xff\x5b\x45\x28\x78\x25\x88\xdc\x2c\x25\x8a\xb2\xb5\xf2\xba\x22\x67\x53\xbb\xa9\x1c\x9a\x83\x26\x31\xcb\xc1\x0c\xdd\x33\x10\xcd\xdd\xca\x77\xdf\x1a\x00\x14\x45\x09\xb0\x4d\xf2\x20\x9f\x6c\x01\x83\xd7\xfe\xe9\x79\x9e\x6e\xe8\xf7\x37\x00\xff\x7d\x03\x00\x70\x21\xcb\x8b\xef\xe1\x22\xba\x98\x74\x3f\x6a\xac\xc9\x6f\xb8\x56\x72\xe1\xe0\xd6\x38\x52\xbb\x5d\xeb\xca\x68\x7a\xd7\xd4\x73\x62\xbf\xe2\xff\xd3\x28\xfb\x0b\xe4\x79\x16\xa4\x79\x18\xee\x16\x95\x64\x05\xcb\xb5\x93\x46\xfb\x45\x6f\x61\x1a\xd4\x52\x37\x8e\x60\x83\x6a\x05\x0b\x36\x35\xfc\xaa\xa5\xd1\x70\xff\xb1\x41\x26\x40\x5d\x42\x0a\xdd\x1a\xdb\xed\x47\xb8\x6b\xb4\x84\x3b\x72\x6c\xc0\x3a\xf4\x27\x9b\x80\xab\xa4\x05\xd5\x7c\x6a\x78\x0b\x95\xbf\x2f\x28\xc9\xca\xa5\xa6\x12\xe6\x5b\x78\x5f\x49\x25\xd7\x6b\x82\x7b\x87\x2c\x56\xb0\x20\x74\x0d\x93\x05\xd4\x80\xec\xec\x16\x16\x0d\x6b\xe9\xb7\x81\x30\x4a\x91\xf0\x67\x05\xa9\xc1\x55\x04\xca\xcc\xe7\xdb\x09\x48\x2d\x54\x53\x4a\xbd\x84\x8d\xe1\x95\x3f\xed\x3d\xaa\x07\x2c\x0d\xc3\x0f\xa8\xe4\xe5\xee\x21\xb1\x2c\x99\xac\xbd\xf8\xbe\x7f\x89\x00\x17\xd6\x31\x91\xdb\xbf\x9d\xb4\xc8\xfa\xd5\xfb\x9d\xfd\xab\xfd\x89\x90\xb7\x70\xef\xf6\xfb\x85\x74\x5b\xbf\xe7\x1e\x35\xdc\x30\x6a\x21\xad\x30\x4f\x0f\x47\xd7\x15\xe5\xed\x93\x63\x4c\xa3\x1d\xb7\x87\xfd\xaa\xa5\xa3\xd2\x3f\xb9\x23\xbb\x5f\xb1\x36\xd6\xa1\xba\x36\x65\x7b\x6c\x91\x46\x61\xbc\xdf\xa9\xd0\x5d\x7c\x0f\x49\x7e\x99\xcf\xa6\xf9\x7e\x6b\x5b\xb5\x20\x8a\xe3\xcb\x34\x8a\xe2\x76\xf3\x9f\xfd\x43\xcb\x1a\x97\xe4\x9f\xf9\xf7\x7e\xf5\xee\xd9\x01\x2e\x1a\x56\xfe\x1a\xdf\x29\xb3\x34\xf6\x3b\xe1\xd9\xb9\xb4\x0f\xcb\xc7\xcb\xb5\x60\x2c\xb0\x51\xfe\xa2\x8e\x1b\xea\xb7\xff\xd9\xfe\xfb\xc7\x9b\xfe\x2a\x07\x48\xc6\xcf\x91\xfc\x0d\x86\xde\xcf\x18\x96\x79\x90\x25\xa3\x58\xfe\x8d\xac\x05\x57\x79\x36\x60\xae\x8c\xe8\xc1\xf4\x28\xfc\x93\x78\x8e\x70\xd5\x90\x46\xb8\x26\xed\x88\x61\x61\xb8\xdd\xf5\x96\x9d\xed\x39\x74\x4c\xba\xdc\x71\x28\x2d\x20\x44\xf1\xe7\x41\x3f\x06\x9e\x68\x16\x8d\xc1\x93\x70\xf9\x2a\xe8\x24\x83\xe8\x64\xe9\x20\x3a\x61\x98\x9d\x88\xce\xc6\x2e\xce\x06\x27\x79\x0e\x4e\xab\x62\xf0\x2f\x72\x0e\x3f\x0b\x4d\x96\x26\xc1\x2c\xcb\xb2\x51\x2d\x4b\x5e\x96\xd8\x73\xf1\xde\x6c\x48\x29\xb8\x6f\x0b\x05\x02\xe7\x8a\x02\x81\x0c\xae\x61\x8d\x6c\x1a\x5d\xb6\x2a\x77\xf5\xf6\x97\x0f\xc0\x28\xd5\x33\x4d\xab\xe4\xba\x07\xa9\x38\xd4\xc1\x03\x9d\x14\xa6\x9e\x4b\x4d\x7e\xf9\xb2\x0a\x1c\x89\x0a\x94\x29\x97\xad\x58\x49\x57\xf5\x22\xe7\x4c\x23\x2a\xb2\xc7\xc0\x96\x8d\x0a\x55\xe6\xaa\x6f\x88\xb5\x64\x84\xb5\x3c\x3a\x91\xb5\xff\x78\x20\xce\xa6\x2d\x1d\xa6\xed\x1f\xd2\xa1\xa2\xcf\xe2\x16\xe7\xb3\x20\x19\xb7\xce\x0f\x9e\x8d\x0d\x3a\xe2\x05\x1b\xed\x7a\x44\xda\x5a\x5f\xe1\x16\xae\x58\x96\x4b\x82\x07\x49\x1b\xeb\x15\x28\xe9\x84\xcc\xee\xb1\xbc\x91\xda\xd7\x08\x15\xfc\x20\xad\x63\x29\x5c\x8b\x21\x42\x3a\x8c\xf1\x0d\x31\x6f\xe1\xaa\x91\xca\x5b\xe0\x31\x08\xcd\xc6\x08\xba\x93\xd6\xb6\x0c\xbf\x02\x45\xd9\x10\x45\x45\x32\x1d\xa2\x28\x29\x92\xf0\x44\x8a\x1e\xda\x42\x9f\x8d\x51\xf6\x1c\xa3\xf7\x95\x21\x2d\x3f\x7d\x55\x04\x9b\x06\x51\x32\x1b\xf5\x3a\x23\xd0\xbf\xb1\x3e\xea\x7c\x20\x5d\x12\x2b\x23\x35\x68\x92\xcb\x6a\x6e\xb8\x32\xa6\x9c\x78\x0b\x0b\x5f\x82\x81\xa3\xb2\xc5\x6d\x3c\xab\x8d\x33\xdc\x2a\x11\x41\x85\x16\x2a\x63\xfd\xc5\x6a\xd4\x5b\x60\x6f\xac\x75\x63\xa5\x90\xa8\x6d\x0b\x9f\x71\x15\x31\x08\x52\x34\x67\xe9\x24\x59\xb0\x52\x0b\x6a\xef\x2c\x2a\xb2\xd0\x5e\xc2\xcf\xae\x33\xd4\x11\x4a\x2b\x69\x9d\x61\x29\xe0\x27\x26\x74\xf0\xb6\x26\x96\x02\x35\xdc\xf9\xeb\xc0\x2d\x2a\x05\x5a\x2e\x2b\x27\x54\x33\x3f\x86\xe1\x69\x38\xea\xb9\x3f\x96\xe5\xeb\xe4\xb5\x62\x58\x08\xa3\xe1\xbc\x36\x3b\x55\x08\xd7\x1d\x6a\x67\x33\x3c\x7d\xce\xf0\xbd\xbb\x84\x5f\x68\x29\xed\x11\xc9\x2d\x9e\xa5\x41\x1a\x8e\xaa\xe2\xfe\x94\x77\x8d\xa5\xa6\x86\x0f\x66\x43\xdc\x13\x13\x07\x9e\x8d\xed\x04\xd2\x59\x0a\x0b\x07\x76\xb5\xb5\x82\x71\xed\x17\x74\xf4\xdf\x9b\xc6\x55\x60\x16\x70\x87\xbc\x22\x07\xe5\x4e\x19\xcd\xe2\xf0\x1e\x27\x70\x8d\x4a\x2e\x0c\x6b\x89\x13\xc0\xf2\xdf\x28\x48\x3b\x70\xe6\x20\x20\xfe\x84\x5c\x92\xb6\x13\xb8\x33\x56\x18\x4d\x7d\x62\x9c\xc0\x7b\x14\x57\x3e\x13\xec\xd4\xb4\x85\xbf\xbd\x81\xa7\x17\xd9\x3d\x83\xbf\x1f\x53\x12\x6b\x1f\x31\x8f\x0a\x8a\xf1\xa8\x79\xbf\x56\x50\x1c\x66\x76\x9a\x0c\x9b\x77\x74\x6a\x50\xf4\x0f\xbb\x94\xf6\x6c\x66\xf3\xe7\xcc\xde\xa0\xe4\xda\xfb\xed\x31\xcd\x46\x1c\x64\xe3\xc8\xde\xee\x14\xeb\xa0\x79\x35\x1a\xde\x99\x39\xdc\x4a\xa5\x60\xa5\xcd\x46\xb7\x6d\x86\xaf\x52\xcb\xca\x1c\xb7\x9d\xb7\x4f\x60\xc9\xad\x6d\xb3\xa8\xa4\x23\xe1\x3b\xd8\x49\xbb\x44\x28\xb4\x5e\xf3\x0e\x91\x12\x15\x32\x0a\x47\x7c\x0c\x47\x45\x16\x8e\x5a\x38\xda\x57\x32\xf0\xd9\xa0\x81\xc7\x69\x1c\x86\xc3\x30\x15\xf9\xb4\x38\x55\x03\x17\x7d\xd9\xcf\x06\x6a\xf6\xc2\xc8\x51\xa1\xa0\xaf\xf0\xf1\x2c\x8a\x83\x28\x8a\xa2\x31\x1f\x47\x5d\xd6\xc8\x2b\x28\xcd\x46\x3b\x0f\x4c\x07\x92\x26\xe4\x9d\x9c\xf5\x2d\x48\xd7\x10\x00\x93\xe7\x8a\x4a\x40\xc7\xb2\xa9\x27\xc0\xb4\x90\xfe\x67\x36\xa6\xb6\x1d\x44\xd6\xb1\xd1\x4b\x98\x37\xd6\x37\x17\x36\x70\x8c\x0f\xa4\x40\xf9\xd0\x20\x8d\x3e\x06\xa2\x78\x0c\xa1\x77\xb4\x81\x3b\xa3\xdd\xd2\xd4\xf4\x4a\x93\x8f\x74\x50\x95\xf2\x22\xcd\xb3\x11\x61\x2a\xa2\x7c\x7a\xaa\x9f\xb6\x15\x3f\x9b\xa4\xe2\x39\x49\x1f\x2a\x82\xdf\xc8\x3a\xe9\x7f\x1f\x2f\x77\xef\xeb\xb3\x4c\x25\x45\x1e\xe4\xe3\xd2\x74\xd0\x61\x4a\x61\x74\x87\xce\x4e\x59\x3c\x20\x75\x67\x4c\xce\xdb\xac\x9d\x80\x77\x41\x46\x05\xb6\x32\xeb\x75\x6b\x6c\x42\x90\xed\x61\x6a\x5b\x5f\xf0\xad\x6f\xdb\xb2\xe0\xf2\xa8\xa9\x47\x92\x8c\x9a\xd9\x63\x87\xfd\xad\xcc\xcc\xf2\x3c\x2d\x06\x3b\x89\x34\x9c\x15\x79\x56\x9c\x48\xce\xa6\xad\xee\xd9\xe4\x44\xe1\x73\x74\x7e\xf6\xb1\xe4\xda\x68\x27\x35\x69\x87\xaa\x15\x0c\xb8\x35\xeb\x95\xd4\x5f\x22\x28\x0e\x92\x34\x49\x47\x08\x7a\xf4\xb0\x76\x2c\xc1\xd2\x52\x87\xd0\x1a\xb5\x61\xac\xa5\xe8\x3b\xd4\xae\xf3\xdc\x81\xc5\xc6\x2c\x9c\x59\x83\x32\x8d\x5e\xd2\x04\x2c\x39\x9f\xd0\x7c\x82\xf2\x41\xec\xe6\xff\x2c\xd4\xc6\xba\x7d\xd8\xdf\xe5\xb4\xa3\x26\x1b\x45\x51\x8c\x01\xb5\x0f\x77\xdf\x90\xb5\x45\x69\x1c\x15\xc3\xd9\x3e\x8c\xe3\x3c\x3f\x11\x2a\x6f\x1a\x55\x57\xe9\xf3\xc9\x7a\xf1\xa1\xe0\x76\x8b\xce\xf9\x48\x4e\x5a\x6c\x8f\x09\x4d\xb3\x59\x10\xc5\xa3\x5c\xfd\x58\xcf\x91\x05\x96\xc4\x66\x64\x08\x82\x50\x32\xd6\xe8\xbc\x4e\xb5\xfe\xd6\x32\xf6\xb1\x91\x62\xd5\x8b\x92\x4f\xeb\x8e\x51\x5b\xe9\x26\x03\x13\x8e\x4e\xb3\x1e\xdd\xd4\x2c\x16\x52\x1c\x39\x3a\x1b\xc3\xeb\x07\x6e\xea\xfa\x35\xc8\x8a\xa2\x41\xb2\xd2\x38\xcd\xe3\xe1\xc1\x47\x56\x84\x61\x72\x22\x59\x95\xaf\xfd\xf9\x4c\xbd\x98\xf4\xbf\x50\xab\xaf\xc7\x6a\x1a\x4d\x83\x69\x36\x6a\x78\xfe\xa4\x54\xaf\x0d\xb7\x9f\x60\xcc\x1d\x1e\x84\xa8\x83\x26\xae\xa3\x6c\xa1\x8c\xe1\xc0\x99\x40\x90\x54\xde\xef\xec\x6a\xab\xa4\xa6\x03\x59\xeb\x3d\xf2\x31\x44\x31\x61\xb9\xf5\xa2\xe6\x8e\x1d\xa4\xcd\x46\x47\x69\xb7\x66\x83\xdf\xd4\xec\x3f\x0e\xa7\xb3\x61\xb5\x0a\xd3\x59\x18\x9d\x1a\x9e\xa4\x7f\xf7\x62\x5f\xfb\xf3\xf1\x1a\xf9\x1e\xf0\x4e\xae\x56\xe6\x08\xb2\x92\x22\xfd\x5c\x3c\xff\x2b\xae\x51\x93\xa5\x40\x6a\xbb\x96\x4c\x25\x34\x6b\x2b\x50\x51\x4f\x98\x50\xc6\x92\x57\xa4\xa7\x99\x6b\xf2\xa4\xf5\xb3\x8a\x68\x05\xed\xe3\x4b\xc3\x1d\x5a\x6b\xa3\xa4\xad\xa8\x04\x4b\xfc\x20\xc5\x51\x71\x2a\x8e\x47\x03\xf9\xeb\xf5\x74\xc3\x69\x2a\x9b\x45\xc9\x58\x4f\x97\xa4\xe1\xec\x44\x94\xb4\xaf\xf0\xf9\xfc\xbc\x98\xf0\xff\xbd\xd6\xf2\x59\xd7\xfd\xe5\xee\x6e\x9a\xe7\x41\x91\x16\x63\x7e\x37\x30\xac\xef\x3f\x2e\x6a\xc0\x7d\x0e\x9a\x3f\x7a\x97\x59\x2c\x88\xbd\x20\xed\x32\xd5\x21\x39\x7d\x5f\xb7\x41\xb5\xc2\xb9\x54\xd2\x6d\x3d\x7b\xd7\x95\xd4\xd8\xba\x5d\x3b\x8f\xea\xbc\xf1\x28\xc7\x0b\x47\x07\x05\xaf\x1c\xa9\x06\x3b\xbc\x22\x89\xb2\xe9\x88\x48\x25\x71\x98\x9e\x4a\x96\xa9\xb5\x3c\x1f\xac\x17\x33\xff\x67\xc3\x41\x64\x96\xc6\x39\xff\x9f\x8f\xcd\x17\x1a\xbd\x59\x31\x0d\xa2\xe9\xf8\x07\x6f\xe4\x25\x81\x30\xfa\x81\xb4\xdf\x16\x18\x96\xde\xe5\xca\x17\x26\xf8\x74\xbc\xe9\x21\xe9\x4d\x71\xd2\xd9\x21\x29\x7a\x68\xbf\x26\xb4\x83\xaa\xbd\x07\xd2\x27\x47\xda\xca\x07\x02\xac\x49\xb7\x93\xfd\x63\xb0\xca\x67\xe3\xf3\xa7\x57\xfc\x84\x34\xf6\xd1\x3b\xce\x46\x7a\xbf\x34\x2d\x92\x53\xff\x6a\xa2\xee\xcb\x7d\x3e\x57\x2f\xe6\xf0\x37\xa6\x61\xb8\x27\xaf\xfa\xb6\x93\xaa\x63\x12\x55\x92\x04\xc9\xf8\x08\xe1\x3d\x53\xed\xc3\xf7\xe1\x04\xea\x49\x4e\xdf\x0d\x9d\x1e\xf5\x69\xf2\xe8\x8c\xa5\xd4\xcf\x47\x08\x6b\x36\x9f\x64\xdd\xcb\xd5\x8b\x3f\x14\xf2\xb0\x1e\xc5\x55\x96\x8f\x7b\x60\x7f\xbf\xdf\x0a\x56\xd3\x38\xca\xc6\xf2\x54\x9c\xe6\xa7\x66\xf4\x85\x69\xd8\x76\xa5\x3f\x96\xac\x37\x7f\xbc\xf9\x5f\x00\x00\x00\xff\xff\x73\xc5\x77\xb9\x29\x26\x00\x00")
+var _dataHotelsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x9a\x5d\x73\xdb\x36\xf6\xc6\xef\xf3\x29\xce\xe8\xe6\xff\xdf\x19\xd1\xe5\xab\x48\xe6\xce\x71\x9b\xba\x3b\x71\x9a\xa9\xd3\xed\xec\x76\x7a\x71\x04\x1e\x8a\x58\x81\x00\x0b\x80\x56\xb4\x3b\xf9\xee\x3b\xa0\x28\xdb\x52\x48\x37\x74\xfc\x76\xe5\xb1\x40\x8a\x2f\x78\x7e\x38\xcf\x73\xa0\xdf\x5f\x01\xfc\xf7\x15\x00\xc0\x8c\x17\xb3\xd7\x30\x0b\x66\xf3\xdd\xbf\x12\x6b\x72\x1f\x9c\x09\x5e\x5a\x38\x57\x96\xc4\x7e\xa8\xa9\x94\xa4\xf7\x6d\xbd\x24\xed\x8e\xf8\xff\x38\x48\xfe\x06\x69\x9a\x78\x71\xea\xfb\xfb\x83\x0a\x32\x4c\xf3\xc6\x72\x25\xdd\x41\xa7\xb0\xf0\x6a\x2e\x5b\x4b\xb0\x41\xb1\x86\x52\xab\x1a\x7e\x95\x5c\x49\xb8\xfc\xb3\x45\x4d\x80\xb2\x80\x18\x76\xc7\x98\xdd\x38\xc2\x45\x2b\x39\x5c\x90\xd5\x0a\x8c\x45\xf7\x65\x73\xb0\x15\x37\x20\xda\x4f\xad\xde\x42\xe5\xee\x0b\x0a\x32\x7c\x25\xa9\x80\xe5\x16\x3e\x54\x5c\xf0\xa6\x21\xb8\xb4\xa8\xd9\x1a\x4a\x42\xdb\x6a\x32\x80\x12\x50\x5b\xb3\x85\xb2\xd5\x92\xbb\xcf\x80\x29\x21\x88\xb9\x6f\x05\x2e\xc1\x56\x04\x42\x2d\x97\xdb\x39\x70\xc9\x44\x5b\x70\xb9\x82\x8d\xd2\x6b\xf7\xb5\x97\x28\xae\xb0\x50\x1a\xbe\x47\xc1\x4f\xf6\x0f\x89\x45\xa1\xc9\x98\xd9\xeb\xfe\x25\x02\xcc\x8c\xd5\x44\xf6\xe6\xed\xc4\x79\x32\x9b\x1f\x0d\xf6\xaf\xf6\x47\x42\xbd\x85\x4b\x7b\x33\xce\xb8\xdd\xba\x93\x2e\x51\xc2\x5b\x8d\x92\x71\xc3\xd4\xed\xd3\xd1\x76\x67\x9e\x9d\xde\x3a\x47\xb5\xd2\xea\xee\xb4\x5f\x25\xb7\x54\xb8\x27\xb7\x64\x6e\x8e\x68\x94\xb1\x28\xce\x54\xd1\x9d\x9b\xc7\x81\x1f\xde\x0c\x0a\xb4\xb3\xd7\x10\xa5\x27\x69\xb6\x48\x6f\x3e\xed\x66\xcd\x0b\xc2\xf0\x24\x0e\x82\xb0\xfb\xf8\x73\xff\xd0\xbc\xc6\x15\xb9\x67\xfe\xbd\x7f\xe6\xfd\xb3\x03\xcc\x5a\x2d\xdc\x35\xbe\x13\x6a\xa5\xcc\x77\xcc\x69\xe7\xc4\x5c\xad\xae\x2f\xd7\x09\xa3\xc4\x56\xb8\x8b\x5a\xdd\x52\xff\xf9\xe7\xee\xef\x1f\xfb\xd7\x5a\x93\xe4\x96\xef\x2e\x32\xdb\xf0\x92\xcf\xe6\x30\x5b\x6d\x6b\xf7\x47\x93\xb1\xd8\x6a\x94\xd6\xfd\xd7\x90\x35\xb3\xfd\x79\x8d\x12\x9c\x71\x3a\x98\x0f\x56\x11\x5b\xff\xe4\x9e\x66\x16\x24\xaf\xaf\xf5\xb9\x1f\xf9\xb9\x75\x77\x32\x0b\xc2\x83\xa1\x52\x13\x9d\xa1\x64\x24\x44\x27\xba\x73\xd5\x6a\xf7\xad\x71\xf6\x6a\x7f\xb7\x9f\xe7\xc7\xe8\x84\xc7\xe8\xfc\x06\x43\xf3\x38\x86\x4f\xea\x25\xd1\x28\x3e\xef\xc8\x18\xb0\x95\xd3\x30\x2c\x85\x62\x3d\x40\x4e\xb2\xff\x24\xbd\x44\x78\xd3\x92\x44\x38\x23\x69\x49\x43\xa9\x74\xa7\xe6\x53\x6d\x4d\xcf\x8b\xd5\x24\x8b\x3d\x2f\xdc\x00\x42\x10\xde\x0d\xe4\x14\x91\x07\x59\x30\x26\xf2\x48\x17\xcf\x22\xf1\x68\x50\xe2\x49\x3c\x28\x71\xdf\x4f\xee\x29\xf1\x8d\x29\x1f\x57\xe0\xa6\xc1\xc9\x3a\x5f\x7c\xab\xce\xc3\xf8\x0e\x9d\x47\xc7\x3a\xef\x8a\x03\xfc\x8b\xac\xc5\x3b\x35\x9e\xc4\x91\x97\x25\x49\x32\xa2\xf1\x53\x88\xbe\x54\xa4\x93\xf1\x07\xb5\x21\x21\xe0\xb2\xd3\x15\x30\x5c\x0a\xf2\x18\x6a\xb0\xad\x96\xa8\x55\x2b\x8b\xae\x78\xbc\x39\xfd\xe5\x23\x68\xe4\xe2\xa8\x54\x54\xbc\xe9\x75\x9f\x1f\x96\x97\x83\xf2\xc3\x54\xbd\xe4\x92\x0c\x54\x7c\x55\x79\x96\x58\x05\x42\x15\xab\xae\x06\x70\x5b\xf5\xb5\xc3\xaa\x96\x55\x64\xa6\xb0\x91\x24\x63\x68\x24\xb6\x7a\x41\x68\x44\x23\x68\xa4\xc1\x3d\xd1\xf8\x8f\x13\xc4\xe3\xc2\xb1\xd4\x84\xeb\x12\x8d\x7d\x61\x88\xc4\xc3\x88\xfc\x83\x5b\x14\x74\x27\x23\x61\x9a\x79\xd1\xb8\x8d\xfa\xe8\xbc\xcf\x06\x2d\xe9\x52\x2b\x69\x7b\x5d\x77\x02\x7d\x83\x5b\x78\xa3\x79\xb1\x22\xb8\xe2\xb4\x31\xc0\x0d\x44\xbb\x62\xd1\xeb\xdd\xb1\xf4\x96\x4b\xb7\xe6\xa2\x80\xef\xb9\xb1\x9a\x33\xdb\xb1\x83\x10\x0f\xb3\xf7\x96\xb4\xde\xc2\x9b\x96\x0b\x67\x87\xa6\xe8\x3e\x9b\xcd\x8f\x86\xfa\x77\x71\xc1\x8d\xe9\xc0\x7b\x06\xe9\x27\x43\xd2\xcf\xa3\xc5\x90\xf4\xa3\x3c\xf2\xef\x29\xfd\xab\x6e\xa2\x5f\x5e\x61\x78\x5c\x03\x94\x1c\xab\xfe\x43\xa5\x48\xf2\x4f\x5f\x95\x1e\x16\x5e\x10\x65\x63\xb2\x7f\xa7\x18\xba\xb2\xdf\xbb\xf4\x8f\x24\x0b\xd2\x42\x71\x09\x92\xf8\xaa\x5a\x2a\x5d\x29\x55\xcc\x9d\xab\xf1\xbf\xd4\x31\x8e\x96\x06\xdd\x25\x8b\x5a\x59\xa5\xbb\xd5\x9e\xa0\x42\x03\x95\x32\xee\x62\x35\xca\x2d\x68\xe7\xb5\xea\xd6\x70\xc6\x51\xba\x10\x51\x80\xb2\x15\x69\x60\x24\x68\xa9\x3b\x97\x0a\x86\x4b\x46\xdd\x9d\x05\x79\xe2\x9b\x13\xf8\xc9\x3a\xfa\x46\xa1\xaa\xb8\xb1\x4a\x73\x06\x3f\x6a\x42\x0b\xa7\x35\x69\xce\x50\xc2\x85\xbb\x0e\x9c\xa3\x10\x20\xf9\xaa\xb2\x4c\xb4\xcb\x29\xc8\x2d\xfc\x51\x1b\xf6\x43\x51\x3c\x4f\xd4\xc8\x87\x88\xcb\xa2\x60\x88\xb8\x38\xc8\xee\x5b\x6c\x9a\x9d\xd4\x1e\x0c\xb9\x06\xf5\x9a\xcb\x95\x03\xad\x51\x4a\x7c\x5b\xb1\xb9\x03\xbb\xc0\x0d\xdd\xc1\xd4\xe2\x98\xa9\x4b\x7b\x02\xbf\xd0\x8a\x9b\x09\xe1\x22\xcc\x62\x2f\xf6\xfd\x31\xba\x6e\xbe\xf2\xa2\x35\xd4\xd6\xf0\x51\x6d\x48\xf7\x0a\x0e\x3d\xa7\xd5\xed\x1c\xe2\x2c\x86\xd2\x82\x59\x6f\x0d\xd3\xd8\xb8\x03\x76\x34\x5e\xaa\xd6\x56\xa0\x4a\xb8\x40\xbd\x26\x0b\xc5\xbe\xb0\xa8\xf2\xf0\x1e\xe7\x70\x86\x82\x97\x4a\x4b\x8e\x73\xc0\xe2\xdf\xc8\x48\x5a\xb0\xea\x20\xc3\xfc\x88\xba\x20\x69\xe6\x70\xa1\x0c\x53\x92\xfa\x50\x33\x87\x0f\xc8\xde\x38\x1f\xb8\x2f\x46\x1d\x8c\xdd\x0d\xdc\xbe\xc8\xfe\x19\xdc\xfd\xa8\x82\xb4\x84\x53\x6d\xa7\x40\x14\x84\xc9\x18\x44\xcf\x95\x65\x86\x19\x5a\x44\x83\x0c\xf9\xc1\x7d\xb3\x8c\x7b\x13\x2b\x6e\x1e\x93\xa1\xd1\x4a\xf6\x64\xbe\x2d\x0d\xef\xa0\x2d\x3d\xa6\xed\x2d\x72\x5d\x3b\xa3\xf5\xf5\xb0\xa5\x69\xe8\x25\xe3\xb0\x9d\xef\xd7\xfe\x83\x0e\x96\x92\xf0\x5e\x2d\xe1\x9c\x0b\x01\x6b\xa9\x36\xb2\xcb\xf0\x4e\x5f\x9d\xca\x97\xb8\xdd\x99\xba\x39\xac\xb4\xfb\x00\x35\xab\xb8\x25\xe6\xda\x58\xf3\xee\x10\x26\xd0\xb8\xea\x71\x70\xa3\xc0\x2a\xd4\xc8\x2c\xe9\x29\x04\xe4\x89\x3f\x46\xc0\x05\x9a\x67\x72\x6e\xd9\x10\x03\x79\x18\x87\xbe\x3f\x8c\x41\x9e\x2e\xf2\xfb\x56\x93\xb2\x9f\xf6\xc7\x40\xe1\x85\x23\x90\x1d\x23\xf0\x01\x05\x32\xfa\x0a\x0f\x97\x04\xa1\x17\x04\x41\x30\x22\xfc\x77\x28\x8b\x1a\xf5\x1a\x0a\xb5\x91\xd6\x49\x7c\x17\x5e\x24\xa1\xde\x97\x8e\x3e\xe2\x77\x79\x06\x41\x93\x23\x81\x0a\x40\xab\x79\x5b\xcf\x41\x53\xc9\x5d\x9b\x57\x2b\x55\x9b\x9d\xec\x8d\xd5\x4a\xae\x60\xd9\x1a\x17\xde\x8d\x67\x35\x5e\x91\x00\xe1\x0c\x23\x57\x72\x8a\xec\xc3\x31\xd1\xbf\xa7\x0d\x5c\x28\x69\x57\xaa\xa6\x67\x6a\xd8\xc6\x43\xea\xcf\xd2\x3c\x4e\x93\x61\xf5\x07\x79\x90\x2e\xee\xa9\xfe\xa6\x9b\xf1\x87\xd3\xfe\x43\xad\xfd\x8f\x9b\x5e\xf2\x63\xe1\x7f\xac\x08\x7e\x23\x63\xb9\x5b\xf0\x4e\xf6\xd3\x7b\x27\x02\x51\x9e\x7a\xe9\xf8\xda\x7f\xd0\x70\xe2\x4c\xc9\x9d\xd2\xf7\x4b\xb7\xd3\x73\xbd\xf3\x2c\xd6\x39\x30\x33\x07\x67\x90\x34\x0a\x30\x95\x6a\x1a\xd7\x8b\x42\xc6\xc8\xf4\xda\xef\x3a\x61\xe0\x3a\x61\x5d\x33\x00\x57\x93\x7a\xb6\x51\x34\xea\x73\xae\x1b\x6e\x4f\x2f\xf4\x70\x58\xe8\x69\x9c\x0f\x66\xf4\xd8\xcf\xf2\x34\xc9\xef\x29\xf4\x4d\x37\xbb\x4f\xb6\xc8\x4f\xca\x0c\x77\xac\xf2\xc1\xb7\x8b\x3d\xf0\x8f\xd5\xfe\x93\xdb\x39\x38\x53\xd2\x72\x49\xd2\xa2\xe8\x96\x64\x38\x57\xcd\x9a\xcb\xbf\x12\x7d\xe8\x45\x71\x14\x8f\x88\xfe\xda\xd7\x74\x8d\x55\xcd\x0d\xed\x54\xdf\xa0\x54\x1a\x6b\xce\xfa\x76\x95\x53\x3f\x5e\xb3\xa0\x95\x2a\xad\x6a\x40\xa8\x56\xae\x68\x0e\x86\xac\xcb\x1b\x2e\x0f\xb8\x58\xf1\xf6\xff\x0c\xd4\xca\xd8\x9b\x28\xbd\x4f\x1d\x93\x7a\xb3\x79\x9e\x8f\x31\x70\x13\x55\x5e\x90\xdd\x09\xe2\x30\xc8\x07\x39\x08\xfc\x30\x4c\xd3\x7b\x72\x50\xa3\x5e\x57\xbb\x99\x7e\x1a\x18\x5e\xc6\x8a\x1f\x04\xc7\x10\x9c\x6f\xd1\x5a\x17\xaf\x49\xb2\xed\x14\xcf\x9f\x65\x5e\x10\x8e\x22\xf0\x43\xbd\x44\xcd\xb0\x20\xad\x46\x9a\xb7\x08\x85\xc6\x1a\x2d\x67\xbd\xd9\xe9\x16\xf8\x3f\x5b\xce\xd6\xfd\x92\xef\x62\xb2\xd5\x28\x0d\xb7\xf3\x81\xce\xec\xae\x22\x5c\x5b\x2b\x55\x96\x9c\x4d\xdc\xa7\x98\xcd\x8f\x86\xfa\x97\xf2\xbd\x6e\xeb\xfa\x39\x20\x08\x82\x41\x08\xe2\x30\x4e\xc3\x21\x08\xa2\x3c\xc9\x7d\x3f\xba\x27\x04\x95\x9b\xfb\x97\x25\xff\x6f\xae\x01\x77\x6e\x52\x04\xe1\xb1\xfc\xbf\xa8\x01\x5f\x4f\xc0\x22\x58\x78\x8b\xc4\xf7\x47\x08\x70\x85\x85\xea\x46\xe9\xee\x17\x0f\xea\x02\x0f\xcc\xff\x41\xa3\x67\x57\x1d\x4a\xa1\x94\xf6\xac\xf2\x18\x71\xe1\x8c\x8f\x59\x6f\x05\x97\xfb\xbd\x0d\x27\x76\xdc\x9b\xa5\x6b\xf3\xaf\x09\x8b\xad\x2b\x15\x76\xea\x5e\x45\x96\x8d\xa9\xff\x5c\x6d\xf0\x45\x6d\x61\x87\xfe\x22\x1b\xae\x01\x7e\x9c\xf9\xc1\x7d\x4d\x3f\x77\x73\xcf\x6e\xe6\xfe\x59\xba\x40\x0f\xd8\x58\x7d\x88\xfa\x10\x1d\x03\xd2\x85\x60\x78\xcf\xd7\x6b\x35\xa1\x3a\x44\x79\x7c\x57\x30\xfe\x3b\x36\x28\xc9\x90\xc7\xa5\x69\xb8\xa6\x02\xda\xc6\x30\x14\xd4\x33\xc2\x84\x32\xe4\x96\xff\xdb\xf1\x61\x7e\xab\x4d\x64\x04\xd1\x1a\xba\x09\xe4\x4a\xef\xe0\x70\x6f\xce\x54\x54\x80\x21\x7d\xc5\xd9\xa4\x64\x10\x86\xe1\x18\x0d\xcf\xd7\xff\x09\x07\x61\x48\xb2\x20\x1a\xeb\xff\x44\xb1\xdf\xcf\xee\x64\x18\xa4\x9b\xe1\xa7\x25\xe0\xc9\x24\x7f\x77\x4d\x88\x8f\x25\xff\x73\x2d\xf9\xa1\xd6\xbf\xa2\x15\xb4\x48\x53\x2f\x8f\xf3\x31\x3f\x34\xb0\x09\xdd\xff\x30\xc9\xfd\xbe\xe9\xda\xd2\x2f\xaf\xbd\x8d\x2a\x4b\xd2\xae\x0a\xec\xe3\xc1\xa1\xd8\xfb\x26\x90\xdb\xf1\xc3\x25\x17\xae\x6b\x6a\x15\x9c\x55\x5c\x62\xe7\x86\xdc\x31\xbd\x77\x9a\xc2\xc1\x4d\x39\x7b\x69\xe9\x20\x1e\x82\x21\x8f\x82\x64\x31\x52\x19\xa2\xd0\xdf\x2f\x75\x93\x61\x50\xb5\xe4\x8f\xc1\xc2\xa3\x66\xe4\x87\x58\xfe\x93\x63\x16\x0e\x31\xb8\x40\xad\xb9\xb2\xd6\x25\xe5\x3f\xdb\xbf\xe8\x0c\x65\xf9\xc2\x0b\x16\xbe\x3f\x42\xc4\x3b\xd4\x2b\x02\xa6\xe4\x15\x49\x77\x83\x9e\xd2\xdc\xb9\xa1\xe2\x0b\xb3\x74\x7b\xab\xcc\xe9\xba\xdf\x25\x9b\xef\x6c\x13\x09\xba\xea\x76\xca\x9d\x12\x6f\x79\x25\xfa\x64\x49\x1a\x7e\x45\x70\x3d\x2d\x53\x48\x48\xb3\x51\x12\x9e\xf3\xd7\x1c\xc3\x06\x29\x89\xc3\x64\xa4\x59\x14\xc7\x79\xd4\x37\xbf\x27\x63\x50\xf7\xd3\xfd\x34\x28\xbc\x90\x88\xf0\xc5\xf6\xf3\x5b\xd5\x6a\xb8\x24\x67\x03\xcc\xae\x10\x4c\x30\x42\x8b\x28\xf2\xa2\xf1\xf6\xe8\x07\x4d\xb5\x8b\xbe\x87\x9b\x01\xb7\x52\xf2\xbe\xff\x7f\xbd\xfa\xcf\xaf\xad\x52\xc1\xe5\x71\x7b\xb4\xd1\xea\x13\xaf\xfb\x62\x70\xd0\x7a\x75\xa3\x8e\xab\x49\x08\x24\xe9\x28\x02\xfb\xfb\x7d\x29\x04\x2c\xc2\x20\x19\x8b\x08\x61\x9c\xde\x37\x21\x97\x4e\x32\xbb\xa9\x7f\x5a\x6f\xf4\x5c\xe9\xe0\xf6\x46\xd9\xab\x3f\x5e\xfd\x6f\x00\x8e\x21\xa9\x2e\x2d\x31\x00\x00")
 
 func dataHotelsJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -140,7 +185,7 @@ func dataHotelsJson() (*asset, error) {
 	return a, nil
 }
 
-var _dataInventoryJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xd6\xcd\x6a\x02\x31\x10\x07\xf0\xfb\x3e\xc5\xb0\x67\x0d\xc9\x24\xd9\x8f\xde\x4a\x0b\x45\x04\x41\xe9\xad\xf4\xa0\x6e\x68\x97\x6e\x37\xa2\xb1\xd0\x16\xdf\xbd\xb8\xb2\x5a\x24\x92\x80\xa4\xb9\xf4\x3a\x13\xb3\xf8\x63\xc8\x7f\x9e\x12\x80\xef\x04\x00\x20\x7d\xd5\x46\x35\xa3\x2a\xbd\x81\x94\xa5\x83\x43\x6d\xa9\x2b\xb5\x2f\xcc\x6e\xef\xc6\x7d\xad\x6e\xef\xe7\xa6\xab\x22\x65\x72\x48\xc5\x90\x96\x7d\x4f\x6f\xcd\x79\x93\xd1\xbe\xb9\xd6\xfa\xfd\xf1\x73\xb5\xef\x1e\x3e\x09\x90\x2e\xb4\x7e\x9b\x2f\x1a\x35\x3b\xfc\x8a\xd1\x92\x50\x3a\xe8\xbb\xfd\xe7\xc7\x93\x87\xf4\x58\xac\xd4\x66\xb9\xae\x57\xa6\xd6\x6d\xd7\xab\xdb\x17\xd8\xd4\x5f\xaa\x82\x85\xaa\x4e\xc7\x8c\x36\xf3\xc6\x7e\xed\xb1\x35\x6a\x97\xcd\x76\x53\x7f\x74\x67\x90\x13\x96\x77\x47\x76\x09\xc0\x6e\x60\x95\xc1\x68\x32\xdc\x2a\x33\x9d\x5c\x84\x99\x6e\x95\x6a\x9d\x32\xdc\x43\x46\x72\x42\x4b\x97\x0c\xff\x9f\x99\x0b\x32\x79\x2c\x19\x2c\x82\xc8\x9c\x5d\x6b\x95\xe1\x88\x44\x14\x2e\x99\x22\x9a\x8c\x0c\x23\x23\xdd\x32\x7b\x3d\x26\x5c\x32\x65\x34\x99\x2c\xc8\x3b\x73\x76\xad\x7d\x66\x28\x25\x88\x2e\x99\xd3\xbf\xf9\x73\x9a\x3c\xcc\xd0\xe4\x1e\x34\x8c\x11\x2e\x9d\x34\xd1\x72\x1b\xed\xe9\x74\x35\x8d\x47\x3a\x61\x96\x13\xca\x9d\x34\xd1\x82\x1b\x45\x18\x1a\xe1\x41\x93\x17\x84\x65\x4e\x9a\x68\xc9\x8d\x18\xe6\xad\x41\x0f\x1a\x29\x49\xe1\xa6\x11\xd1\x68\x58\x98\xa9\x61\x1e\x34\x42\x90\xcc\xb9\xee\x31\x19\x8d\xc6\xbe\xef\x5d\x3d\x35\x1e\xfb\x1e\x72\x4e\xa4\x3b\xa1\xb2\x68\x34\x65\x98\xa9\x29\x3d\x12\x8a\x73\x92\xfd\x5a\x6b\x92\xe7\xe4\x27\x00\x00\xff\xff\x50\xc6\x11\x38\x5c\x0e\x00\x00")
+var _dataInventoryJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x97\x51\x6b\xdb\x30\x10\x80\xdf\xfd\x2b\x84\x9e\xd3\x21\x9d\x24\xdb\xea\x9b\x59\x61\x8c\x40\xa0\x5b\xf7\x54\xfa\x90\xd9\x5a\x6b\x48\xec\xa0\x38\x63\x5d\xe9\x7f\x1f\x6e\x9c\x2d\x98\x05\x2b\x3e\x85\xd9\x66\xaf\x97\xcb\xe5\xf2\x7d\x92\x4e\xba\x0f\x08\x79\x09\x08\x21\x84\x3e\x95\x95\x59\x7d\xcc\xe8\x35\xa1\x9c\xce\xf6\xb1\xb4\xcc\x4c\x1d\xf8\x94\xbc\x9f\x1f\x62\xb6\x2c\xd7\x77\xcf\x9b\x3a\xbe\xff\xe6\x51\xde\x7c\xf1\xa1\x49\x23\x84\x66\x66\x9b\xda\x7c\x53\xe5\x65\x51\xd7\x98\xe7\xc5\x23\xd9\xe6\x3f\x4d\x46\xbe\x9a\xec\x4f\x5a\xba\xb3\xd6\x14\xe9\x73\x9d\xf3\xe5\xf3\x0d\x7d\x8b\xbf\x36\x3f\x56\xe4\x8f\x4f\xd5\x96\x5e\x93\xfb\x26\xfd\x85\xd0\x6f\xb6\x5c\xd7\xc9\xc0\xb8\xba\x62\xf2\x8a\x71\x3a\x23\xb4\x2a\x8f\x63\x9c\xd5\x31\xbb\xac\xea\xb6\x38\xd3\xef\x18\x23\xaf\xb3\xd3\x35\x38\xfb\x4b\x0d\x38\xae\x21\xba\x6b\x40\xbb\x86\x6a\x7a\x6b\xf5\xf1\xd6\xc6\x43\xd0\xfc\x4b\x07\xfc\x49\x92\x8c\x97\xbe\x8e\xf1\xf0\x41\xa1\xe1\xeb\xb8\x1f\x7b\xd7\xa5\x7f\xbb\x38\xc9\xfe\x76\x67\x4c\xf1\xaf\x96\x3e\xf3\x40\x3f\x46\xd3\x3f\xf4\xd1\x85\x1f\x26\x86\x5f\x68\x3c\xfe\xd0\xc3\xc9\x23\x74\x3f\xfc\x8e\x27\xcf\x40\xe9\x83\xc2\xd3\x57\x80\xa7\x0f\xca\x89\xbe\x68\xd3\xff\x3f\x76\xf7\x63\x77\x46\x68\xba\x2a\xb7\x26\xbb\x2b\x6f\xcc\x66\x69\xab\x9d\xad\x3f\xac\xec\xce\x20\xcd\x38\x4e\xe4\x68\x5a\x66\x20\xc6\x9b\x11\xbc\x31\xb3\xce\x8b\xc5\xa1\x29\xc0\xe9\x80\xb8\xa7\x8e\x51\x5f\x90\x20\xc4\xcf\x68\x88\x23\xff\x36\x42\xe6\x64\x23\x6e\xdb\x18\xf9\xe6\x50\xf8\xcd\x01\xf1\x05\x36\x87\xd2\x97\xd5\x31\xcc\x21\x0e\x52\xe0\x75\x44\xe0\x5f\x87\x14\x4e\x3a\xf4\xc4\x74\x84\x1a\xaf\x43\xb7\x86\x7a\x62\x6d\xfe\x7d\xb9\xf2\x32\xd2\x21\xd4\xfd\xbc\x8c\xfa\xaa\x0b\x12\xf0\x5a\xc2\x4b\x6a\x91\xe0\xa4\x85\xb3\xb6\x97\x91\x4f\x93\x08\xbf\x5f\x84\xc3\x45\xba\x93\x7f\xa4\xdd\xf8\xf3\x89\xf1\xf7\xf0\x02\x87\xb0\xbb\x46\x27\x7f\xc7\x17\x38\x87\x89\xf1\x97\xcd\xa1\xb2\x5e\xfe\xf8\x3d\x7a\x23\xa4\x8f\xe8\xfc\x9a\x9d\x7e\x4e\xf4\x79\xbe\xaf\x71\xbf\x45\x40\xfa\xd7\xa5\xb8\x7f\x5d\x20\xfb\xe9\x12\x13\xbb\x8e\x81\x87\xe3\xcd\xe1\xc1\xd3\xed\xc3\xf1\x78\x93\x7d\xf9\x0f\x74\xbf\x70\x0f\xfc\xa5\x07\xfe\xdc\x91\xbf\xea\xcb\x7f\xa0\xeb\x9f\x79\xe0\x2f\x3c\xf0\x67\x8e\xfc\xc3\xbe\xfc\x07\xba\xfe\x35\x7e\xfd\x0b\xf0\xc0\x5f\xb7\xf9\x07\x0f\xc1\xaf\x01\x00\x47\x13\xe7\xca\xdd\x1e\x00\x00")
 
 func dataInventoryJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -160,6 +205,66 @@ func dataInventoryJson() (*asset, error) {
 	return a, nil
 }
 
+var _dataNeighborhoodsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x94\x4f\x8f\xb2\x30\x10\xc6\xef\x7c\x8a\x09\x67\x5e\x53\x29\x7f\x5a\xaf\xef\xc6\x9b\xc9\x26\xee\x9e\x08\x87\x06\xbb\xa6\x09\xb6\x6e\xa9\x07\x63\xf8\xee\x1b\xb0\x60\x89\x95\xcb\x86\x3d\x69\x9e\x99\x67\xa6\xf3\xcb\x0c\xb7\x00\x20\x34\xd7\x33\x0f\x37\x10\x6e\x39\x33\x17\xcd\xff\xab\xba\xe6\x95\x11\x4a\x86\x51\x17\xfe\xba\xcb\x4d\xb8\x81\x22\x00\x00\xe8\x4c\x00\xcf\xc6\x3e\xbd\x0f\x9c\xb5\x3a\x73\x6d\x44\x6f\xba\x41\x28\xd9\xa9\x4f\xfc\x94\x42\x49\xd8\x7f\x5f\x98\xe6\x21\xb4\x63\xfe\x91\xab\x13\x37\xfa\xda\x65\x5b\xcd\x29\xff\xae\xea\xeb\xd1\xbe\xc6\x86\x2a\xa5\xf4\x41\x48\x66\xfa\x0e\x45\x31\x46\x00\x8a\x7f\xeb\x38\x5e\x25\xeb\x35\x8a\x00\xe7\xab\x9c\xa4\xa8\x8c\x06\x15\xe1\x59\x95\x22\x54\x46\x33\xb5\x28\x72\x5c\x93\x0e\xa3\xa9\x1c\xfe\xb6\xfd\x6f\x1b\xfd\x12\xd9\x56\x48\x26\x2b\xc1\x6a\x78\x13\x8d\xd1\xa2\x32\x0b\x83\x43\x99\x67\x58\x4c\xe3\x79\x95\x78\xc1\x3d\x6a\x91\x47\xad\x69\x87\xc5\xc0\xed\xd5\x8e\x2d\xbd\x63\x24\xbd\x43\xc9\x93\xd4\xb3\x4d\xee\x8e\x61\x8a\xd3\xb9\x1d\xc3\x84\x0c\xae\xcc\x75\x21\xab\xe6\xf1\x43\x4d\x50\x6a\xd5\x2c\xf3\x62\x9f\xbc\x6b\x31\xc0\x1f\x5c\x1e\xb8\xae\x95\x90\x4b\x63\xa6\x76\x1c\x82\x5c\xcc\xd4\x42\x20\xf8\xd5\x51\x46\x9e\x5a\x63\x3c\x77\x5d\x34\x7d\x86\x3f\xed\xbb\x18\xc6\x9d\x68\x9a\xee\xab\xf8\x47\xe7\x1d\xe7\x16\x40\x46\x9d\x51\x91\x5f\x1d\x0e\x35\xf1\x9f\x77\x3c\xec\x61\xe2\x9e\xf7\xa4\xc3\x4b\x6c\x01\x40\x19\xb4\xc1\xcf\x00\x64\x1d\x5f\xe5\x81\x06\x00\x00")
+
+func dataNeighborhoodsJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataNeighborhoodsJson,
+		"data/neighborhoods.json",
+	)
+}
+
+func dataNeighborhoodsJson() (*asset, error) {
+	bytes, err := dataNeighborhoodsJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/neighborhoods.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataTaxesJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x53\x4d\x6f\x13\x31\x10\xbd\xef\xaf\x18\xf9\x1c\x21\xdc\x36\x6d\xc8\x0d\xba\x5b\x51\x89\x04\x89\xb4\xa7\x2a\x07\xe3\x1d\x92\x51\xbb\xe3\xc5\x76\x50\x57\x55\xff\x3b\x1a\x67\x41\x49\x64\xd8\xed\xe5\x29\x9e\xf8\xe9\x7d\x8c\xf7\xa1\x00\x78\x29\x00\x00\x14\x9b\x06\xd5\x1c\xd4\xca\x30\xdc\x78\xc3\x96\x82\x75\x6a\xb2\xff\x73\xeb\x22\x3e\xdd\xd6\x41\xcd\xe1\x41\x69\x35\x01\x75\x26\x70\x2e\x70\x21\x30\x15\xb8\x14\xb8\x12\x98\x09\x7c\x10\xd0\xef\x13\x26\x8e\x4e\x24\x9d\x58\x3a\xd1\x74\xe2\xe9\x4b\xb5\xee\x85\xec\xd6\xf8\x0d\x26\x9d\x34\x00\x78\x01\x65\x5d\x9d\xac\xdd\x7d\xbd\x93\xeb\x35\x06\xeb\xa9\x8d\xe4\x38\x4d\xbd\xe1\x40\xc8\x11\x9c\xb5\xbb\xd6\xb0\xed\x20\x9a\x67\xb9\x19\xbb\x36\x11\xfb\x63\x8b\xde\x22\x47\x35\x07\x7d\x01\xaf\x93\x8c\xc0\x6d\x99\x13\x70\x3b\x4f\xa1\x01\x6a\x5a\xef\x7e\x61\x23\x52\x35\x85\xe8\xc9\x46\x30\x21\x60\x08\x32\xfb\xbf\xe0\xbb\x29\xbc\x26\xc1\x75\x01\x7b\xed\x93\xde\x17\x2e\x58\xc7\x08\xd5\x73\x2b\x79\x1c\x43\xd9\x6b\x64\x97\x70\xd2\xff\xec\xa0\xe5\xe9\xb8\x36\x17\x55\x2e\xec\x1f\x1b\xf8\xd7\xc6\xdb\xa3\x9e\x0d\x65\xbd\x31\xe4\x1b\xc7\x11\x86\x1f\xdb\xd5\xb8\x30\xf7\xdf\x3e\x7d\x5c\x66\xe2\xdc\xfb\xef\x86\xa1\xc6\x10\x89\x8d\x64\x84\x1f\x88\x87\xf6\xfb\x63\x8b\x7e\x49\x9b\xad\xf8\x1f\x74\x7f\x64\x1a\x16\xc6\x7b\x72\x31\xca\x8f\x9f\x3b\x0a\xd9\x14\x63\x77\x52\x56\xab\xdc\x13\x2f\x0f\xfc\x9b\x06\x99\x62\x37\x22\xc7\x6c\x20\xc7\x67\xe9\x19\x96\xf4\xf8\xe8\x46\x2c\x42\x9f\x8f\x8b\x70\xfd\xa5\xca\x6e\xe2\xfa\x09\x0d\x13\x6f\xfe\x6d\x7c\x15\x4d\x27\x9f\xca\x51\xff\xc5\xba\xf8\x3d\x00\x11\xab\x12\x7d\xa5\x04\x00\x00")
+
+func dataTaxesJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataTaxesJson,
+		"data/taxes.json",
+	)
+}
+
+func dataTaxesJson() (*asset, error) {
+	bytes, err := dataTaxesJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/taxes.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataTranslationsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x58\x3d\x8f\x1c\xc7\x11\xcd\xf5\x2b\x0a\x97\x30\x59\x12\x3c\xde\xe9\xcb\x19\x49\x51\x22\x01\x9f\x41\xe8\xa8\xc0\x30\x1c\xd4\x74\xd7\xed\x34\xd5\xd3\x35\xac\xee\x5e\x72\x65\x08\xf0\x7f\xd0\x1f\xd0\x29\x31\x37\x60\x60\x88\x91\xd3\x81\xfe\x97\x51\x3d\x33\xfb\x71\x3b\x47\x0d\xa5\x4c\xdc\x5b\xcd\x54\xbd\x7a\xef\x55\xbd\xfd\xc7\x27\x00\xff\x3a\xa9\x39\x91\x7f\x66\x4f\xfe\x02\x27\xa7\x27\x0b\x38\xf1\x6c\xd0\x93\xfe\xf3\x4a\xf4\xdf\x96\xa2\x11\xd7\x26\xc7\x41\x3f\xec\xfe\x0d\x9f\x41\xe3\x42\x4e\x14\xa1\xfb\x19\x5a\x47\x16\xec\x9d\xef\x82\xe3\x00\x97\xaf\x32\x0a\x01\x25\xfd\xcb\xf9\xf6\x6b\xf6\x4e\x0e\x04\x31\xa1\x3e\x04\x6c\x86\x8b\x1c\x1c\x5c\x50\x12\x5e\x80\xa1\x04\x75\xf7\x3e\x91\x07\x4b\xe0\xf3\x1b\x02\xc3\xa1\xfb\x4f\x86\x16\x05\x9e\xd7\xce\xbb\xb6\x25\xb8\x4c\x28\xe6\x7b\x68\xa5\xdb\x44\x0a\x89\xc0\x62\x88\x10\x39\x40\x8d\xde\x83\xbe\xc0\xb0\xf7\x64\xfa\x77\x10\x34\x5c\x39\xef\x48\xc0\xde\x41\x49\x0b\xb0\x1c\x12\x58\x8a\xf0\xdb\x4f\x79\x25\x5a\x15\xc1\x25\xfa\x15\x5a\x16\xf8\x0a\x7d\xf7\xee\xde\xc9\x8f\x8b\x9b\x90\x3c\x98\x07\x49\xc3\x2e\xf4\x7d\x42\xdb\xfd\x92\xba\x8d\x3e\xbd\x41\x17\x59\x3f\xce\xf0\x77\x92\x0a\xe1\x51\xa6\x80\xf0\x58\xcb\x17\xb8\x62\x81\x54\x13\x3c\x94\x14\x0f\x50\xa8\x04\x83\xa9\xbb\x0d\x44\x82\x24\x9c\x57\xa4\x68\x9e\x3e\xf8\x1d\xd4\xa7\xaa\x3f\x9b\x57\xfd\xd9\xf1\xa3\x33\x24\x12\xfd\xb4\x94\x6f\xb0\xf2\x04\x06\x45\xdb\x7a\xce\xaf\xc9\x7b\xb8\x4c\x42\x94\x74\xd6\x3a\x36\x84\xa5\x4e\xfe\xd1\xc3\x6f\x5f\x1c\x34\x93\x28\x58\x0c\x86\x20\xba\x94\xbb\x8d\xbe\xe0\xcb\xed\xdb\x6e\xd0\x06\xbd\x77\x04\x75\xb7\xa9\x48\x96\xd4\x50\x48\x50\xbb\x65\x7d\x37\x91\xa9\xf5\x3d\x89\xb3\xa9\x29\x02\x4a\x72\x31\xb9\x57\x99\xe2\x54\xd3\xe7\x33\x9a\x7e\xbc\xab\x90\x02\x54\x2c\x56\x3b\xab\xd0\x11\xe0\x8a\x0c\xac\x32\x41\xcc\x02\x9e\xe0\x11\xae\xe1\x91\x38\xbb\xa4\xc3\x81\x9c\x81\x64\xed\x21\xc3\xd7\x2e\x60\x30\x0e\x3d\x7c\xe5\x62\x12\x67\xd2\x91\x02\xf6\x70\xfd\x9a\x44\xd6\xf0\x28\x3b\x6f\x5d\x58\x4e\x35\xf0\xe9\x8c\x06\x2e\x7b\x38\x8b\x08\x3c\x81\x32\x20\x15\xb2\x67\x78\x41\xc1\x92\x78\x76\x61\xa1\x45\x9c\xde\x3f\xae\xa2\xe8\xf1\x60\x60\xd0\xe8\xfb\x41\xba\x4d\x12\x06\x34\x26\x93\xf3\x9e\xc0\x52\x9b\x5d\x04\xaf\xb0\x87\xd0\x6d\x28\xc2\xe9\x97\x9f\xde\x57\xb4\x02\x37\x95\x50\x7e\x03\x4d\x8e\xce\x38\x52\xa6\x13\x08\x9b\xef\xb5\x7d\xcc\x49\x45\x66\xba\x8d\xef\x36\x95\xb8\xd4\x6d\xe2\x3d\x78\xe6\x0f\x41\x9c\x40\xa8\x90\x29\xa2\xbe\xbc\x76\x31\xb1\xe8\xa0\x15\xe6\x6f\x84\x30\xc1\xc3\x86\xc4\x19\x0c\x70\xa1\x6f\x85\xa7\xe8\xfd\x14\x86\x9f\xcd\xc0\xf0\xaf\x08\x97\xe9\x1e\x7c\x4b\x4b\x17\xf5\x71\x94\x1b\x78\xc1\xaf\x49\x80\x62\x82\x1c\x60\x29\x98\x12\xdd\x35\xae\xf7\xa6\xf3\x07\xd0\x6d\x12\x2e\x29\x6a\x83\xa7\xe7\x5f\x40\xd3\xbd\x2d\x5d\xc6\xdb\xa6\x41\x70\xc9\x39\xd5\xc0\x57\x70\x81\xf2\x7d\x4f\x8b\x4b\x0c\xf0\xb5\x8a\xdc\x45\xc3\x65\x46\xa6\x7b\xdf\x7b\x46\x3c\x70\x8a\x6f\x50\x2c\x85\xb8\xd0\xee\x2f\x38\x1a\x0e\x34\xb8\x47\xf9\xe8\x39\x9a\x47\xaa\xc4\x91\x4b\x5a\x95\xcd\x87\x8f\x1f\xfb\xd2\x02\xd8\x92\x04\xf5\x9b\x29\xc0\x3e\x9f\x01\xd8\xd3\x43\x9b\xde\x1f\x0f\xc1\xdf\xb8\x82\xa7\xce\x17\x0e\xb5\x59\xdb\x69\x39\x0b\x44\x8a\xaa\xa6\xd8\xcb\x09\x61\x55\x68\x45\x49\xff\x5b\xf5\xb6\x28\x1e\x8e\x62\x6a\x97\xc8\xa4\x2c\xa4\xb0\x07\xeb\x38\x96\x45\xa2\x7f\x35\x28\x68\x52\xf7\x56\x08\xd2\xba\xd5\x17\x16\x73\x88\x18\xee\x5e\x0d\x8d\xa2\x0b\x53\x5d\x7d\x31\xd5\x55\xc0\x86\xf6\xda\x79\x8e\x1e\x0d\xdd\xde\x2e\x35\x95\xef\x36\x0d\xa6\x91\x88\x86\x42\x12\xba\xdb\x77\xd2\x4a\xf7\xb6\x10\x7f\x98\x6f\x6f\x8b\x8b\xde\x46\x72\x00\x4c\xe2\x72\xd3\xeb\xe0\x6d\x25\xb4\x28\x3b\xc8\xd4\xa8\xe2\x89\x20\x78\x75\xe5\x7a\x5d\x51\xe1\x1c\x35\xad\x96\x53\x1a\x74\xb6\xdb\xa0\xef\x71\x54\x05\xae\x78\x5d\xd8\x67\xef\xe0\xd5\x15\x3a\x99\xf6\xbf\x2f\x67\x4c\xf2\x99\xe9\xde\x07\xba\xb9\xb7\x31\xbf\x81\xc4\x59\x22\x18\x8f\x31\x96\x7e\x29\x41\x53\x88\x43\x0b\xc0\x0c\xe6\xb7\x9f\xb2\xf2\x3a\x42\xc5\xb9\x20\x52\x0a\xb7\x14\x23\xc9\xca\x51\x59\xda\x9e\x76\x1b\x63\xaa\xc2\xd3\xfb\x33\x4a\x7c\xa1\x5d\xef\xf3\x8a\xaf\x74\xd8\xba\x6e\x06\x46\xb5\x18\x58\xb0\xd9\x16\x91\x03\x54\x28\x7b\xb5\x0f\x0e\x9e\xd8\xe9\xfe\x2f\xe2\xd4\x0d\xad\x0f\x18\x25\xaa\x7a\x8d\xd0\xfa\x1c\xf7\xe8\x3c\xdc\x06\x7b\x32\x9a\xec\x62\xce\xb9\xf4\xf4\x68\xc9\xf8\x3b\x4f\x9a\x0a\xc5\xa0\x25\x61\xb0\xac\x4a\xb1\x77\x76\x4c\x89\x2d\x99\x84\x26\x7b\x1d\x70\x71\x07\xab\x26\xdb\xa2\x56\x15\x21\x09\x86\xd8\x72\x39\x1a\x8e\xb6\xc9\x30\x0a\xa8\xb2\x90\x0e\xf3\x06\x59\x27\xbb\x78\x30\xbf\x0b\xc3\x21\x51\xd3\xb2\xa0\x53\x14\xe1\x92\x2f\x70\x14\xc0\xb1\x43\x15\x05\x94\x6a\xd0\x29\x79\x5d\x92\x6e\xb3\x33\x02\xef\x96\x85\x81\x35\x8b\xfb\x81\xc3\x30\x40\x83\x56\x68\x64\x1c\xa0\xc5\x56\xf1\xd1\x5e\xe6\x91\xff\xf4\x6c\x7e\x3b\x35\x66\x85\x0b\x96\xd8\x34\x5a\x89\x0b\xb1\x75\xd2\x1f\xa9\x2f\xb1\xe5\x80\x2e\x6e\x05\x7e\xa0\x94\x85\x9e\xa8\x21\xef\x1c\xce\x85\xd4\x6d\xc4\x91\x6a\x47\xed\x4f\xaf\xd4\xd1\xbd\x8a\x30\xf4\x02\x62\xb7\x0c\xdd\x66\xb2\xea\xf3\xf9\x55\x4f\x1f\x1c\xe5\x10\x50\x01\x74\xbf\x24\x37\x9c\x4e\x23\x9d\x55\xb8\x6f\x0e\x4a\xdc\x4a\x24\xf6\x04\x73\x31\x95\x23\xad\x1c\xad\x62\x6a\xdd\xfb\xf0\xb8\x76\x01\x13\xbf\x0e\x23\xab\x76\xd4\x9b\xec\x61\xce\xd9\xf2\x8d\x5a\xfb\x78\x79\x59\x3d\xd9\xc3\x72\x34\xd0\x7d\x36\xd1\xc1\x1e\xa4\xf4\x41\x7e\x15\x33\x20\x0d\x02\x39\x51\x96\xa3\x4d\xb3\x7f\xab\x74\x9b\x57\xd9\xb5\xe5\xb8\x9c\xee\xe2\xb3\xf9\x93\xb8\xc1\x9f\x1b\x2b\xe0\x08\xf4\xd1\xea\xe3\x50\x3a\x1a\xa3\x9d\x77\x3f\x97\xf0\x92\x8a\x61\x8e\xbb\x93\x16\x93\xbc\x1b\xba\x51\xa4\x66\x5d\xbe\x77\x1f\x3f\xfc\xf0\xf1\x8b\x79\xe7\x4b\xf8\xb1\xf7\xaf\xe9\x43\x0f\xe9\x21\x3c\xf3\x0e\x3e\x20\xd8\x18\x1a\x6c\x56\x57\x5b\x91\x44\x47\x32\x6b\xa3\x4d\xf7\xf5\x67\x97\x5a\x86\x06\x97\x18\x5d\xc0\x25\x4d\x2e\xb5\x24\xd8\xbc\xc6\xb5\x4a\xc6\x74\xbf\x54\xb7\x18\xea\x61\xb1\x14\x27\x2a\x7d\x38\x66\x68\x8e\x80\x7a\xf7\x2a\x8a\x07\x55\xaf\x01\x47\xd8\x58\x8d\x1f\xb2\xea\x20\x26\x34\xae\xfb\x55\xcd\xd7\x1f\x84\x68\x8a\x89\xa0\x20\xa6\xdf\xf5\xf9\x25\xab\xa8\xa9\xfb\x2f\x5a\x86\x96\x8f\x73\x34\xbd\xa9\x5d\x45\xba\x97\xc8\xc3\x8a\x62\xea\xde\x55\xd9\x73\x79\x8d\x61\x4f\x66\x7c\xcf\x90\xa3\x51\x1c\x6b\xf0\xea\xde\xc5\xe4\x0c\x83\xa2\xe8\x82\xf1\x79\x4d\xc0\x95\xe0\x1f\x8b\xd3\xb7\x80\xd3\x50\xd8\x35\x6d\x32\x5a\xc1\xd2\xf1\xef\xa6\xe8\x43\x18\x1a\xb6\x05\xb3\xee\x1a\x70\x9b\x9f\x6f\x43\x7c\x46\x7c\xbe\xa5\xda\xb3\xe3\x07\x7b\x84\xd6\x63\xc2\x2b\x96\x06\x61\xa9\x4b\x85\xc5\xf5\x4d\xa8\x8b\xae\xba\x77\x78\x1c\xa5\xd7\xc3\xff\xba\x3f\xe6\x21\xa0\x95\xc6\xfa\xad\xc8\x43\x83\x38\x06\x69\x8e\x37\x7b\x01\xc3\x4d\xe5\x02\x02\x7a\x7e\x89\x8d\xa3\x90\x58\xbf\x83\x3e\x21\x24\x32\x81\x3d\x2f\xb5\x04\xc3\x01\x12\xeb\x1e\xd8\x9b\x6d\x9c\x61\x2d\x93\x50\x3c\xd9\xa1\x7f\x25\x1a\x53\x00\x7b\x57\xa9\xc7\x77\xad\x74\xd1\x44\x40\xbf\xef\x2a\xe3\x84\xce\x86\x51\x6b\x3b\x7e\xca\x4c\x0e\x35\x31\x82\xed\x6f\xdc\x40\x33\x12\xf5\x64\xf5\xdf\x55\xce\xa8\x5a\x7a\x49\x54\x28\xca\x78\x7d\xfc\x7e\x9e\x46\x38\xbd\x7f\x54\xc1\x91\x36\x0f\x87\xa6\x78\x88\xfe\xd6\x05\x35\x82\x90\x71\x95\xb3\x0c\x08\x4d\x36\x35\x47\x68\xba\xff\x45\x45\x7d\x1b\x9e\xd7\xc0\x49\x05\x65\xc8\x53\x25\xce\xa2\xde\x50\x96\x62\x4f\x0e\xdb\x6d\x0c\xda\xc2\x1e\x0d\xe2\xf7\xe0\xc9\x00\xdf\x31\x32\xfa\x75\x3d\x6a\xbb\x5f\x35\x31\x43\x44\x8f\x7f\x2a\x44\x53\xfc\xc8\x10\xad\x79\x46\x30\x1a\xd4\x04\xdd\x77\x78\xfe\x00\x5a\x17\x39\xc2\xba\x0f\xd0\x8a\x4b\x1c\x30\xb7\x65\xd0\x89\x8f\x32\xf3\xcd\x6b\x7c\x01\x2f\xb3\x52\x1a\xc1\xf3\x2d\x89\x99\xfc\xd1\xbd\x40\xfe\x38\x31\xaf\x75\xd6\x5a\xb5\x8e\x5a\x4d\x84\x86\x98\xcc\xb3\x22\xc0\xe7\x33\x00\x7a\x7a\xe0\xca\xdb\x79\x14\xa2\x6d\xb3\x8d\xe1\xc0\xc6\x0d\x5e\x1d\x73\x1c\x95\xd2\x0f\xd1\xb8\x6c\xd1\xc2\x7a\xa7\xa6\x05\xc4\x5c\x72\x32\xa0\xbc\xca\x43\x72\x46\x58\xeb\xc7\xc6\x77\xd7\xca\x28\x4d\x5f\xdd\xb5\x49\x24\xb3\x7a\xb9\x11\x95\x29\x1e\x44\x65\xfe\x60\x52\x7e\xd2\x54\x9e\x9a\xee\x3a\xe9\x6b\x47\xff\xf5\x7d\xf6\x60\x30\x24\x06\x27\x22\xb2\x3a\x82\x9e\xfc\xdd\x46\x89\x4e\x25\x00\xf1\x02\x6a\xac\x9c\x1a\x20\x07\x8a\x20\x74\xe5\x02\x5a\x54\xc2\xa8\xcc\xb2\xaa\xb4\x37\x47\x67\x49\xc3\x31\x8a\x9e\x7a\xf8\xb2\x88\x04\x02\x2d\xd9\x38\x8e\x33\xce\x88\xc9\x69\x3d\xd3\x49\x4c\xf8\xa9\xfa\xa4\x68\x60\x1f\xd0\x55\xac\x7b\x3f\xc6\x85\xfe\x5c\x46\x51\x37\xae\x90\xe9\x69\x89\x11\x92\xd3\x9f\x3e\xb5\xee\x16\x05\xed\x0d\xf3\xa7\xa0\xc3\x6c\x33\x49\xc2\x39\x11\x79\xb2\xd8\x17\x2c\x72\xf8\xdb\xcb\x9e\xc9\x96\x74\xdc\x5d\x37\xce\x0c\xe0\xed\x88\xa1\x29\xb9\x2f\x00\x7f\xe0\x44\xb8\x50\x2e\xe6\xbe\x6f\x55\x54\x6f\x7e\x6a\x4d\xd7\x71\xe7\x20\xfc\xc7\x62\xf1\x64\xe5\x4f\x0f\xb6\x84\x87\xfd\x48\x3c\xd0\x82\x76\x41\x58\x7a\x6a\xc0\x7a\x44\x5a\xba\xeb\x56\xd5\x82\x7e\x97\x87\x69\x01\x47\xbb\xa0\x48\x26\x02\x5f\x39\xe3\x02\xc6\x3d\x52\xce\x89\xc2\x1f\xa8\x7c\x9b\x84\xbb\xeb\x40\x45\xca\x7a\x93\x6f\x99\x7e\x6c\x3e\xda\xd4\x8a\x42\xc2\x80\xfa\x83\x83\xa5\x62\x83\x50\xd6\x71\xcd\x50\xa3\x71\xa8\xf5\x0f\x69\x38\x51\x3f\x32\x6c\x2a\x47\x61\x6f\xf7\x7f\x04\xdd\x4f\xcf\x66\xb7\xa3\x49\x0c\x13\x2d\x59\x86\xc3\x64\x88\xc2\xbd\xd0\x4a\x16\xa6\xb8\x6b\xef\x40\x1c\x8b\x63\xf3\x72\xda\xb3\x63\x95\x0b\x79\x5a\x62\xd0\x1f\x78\x8b\x37\x95\x7b\xda\x38\x06\x8a\x0d\x09\xda\x69\x06\x9d\x7f\x44\xe1\x93\x97\x42\xa1\x33\x90\x75\x3a\xf8\x7d\xd3\x2d\xc5\xee\xd7\x37\x6a\x42\xeb\x43\x68\x59\xc7\xa7\x47\x8a\x3e\x70\xb7\x48\x77\x51\x78\xbd\x95\x71\x4f\xba\x39\x49\x78\xb2\x7e\x4d\xc2\x5b\xab\xd4\xaa\x56\x14\x06\xcf\xbb\xc9\xa6\x83\x0d\xb7\x86\xdb\xd8\xa5\x65\x17\xc0\x57\x68\x8f\x57\x47\xc8\x0d\x09\xeb\xe6\x1d\x67\x30\x2b\xff\xce\x26\x4d\xcc\x6d\xc1\x54\xb5\x70\xe0\xf3\x37\x11\x1f\x1c\x9d\xe3\xd6\x37\xf5\x2a\x8a\x09\xb3\xfe\xba\xd7\x6b\x03\x7d\x62\x08\x6e\x45\x1e\xd6\xd3\xac\x83\x35\x5c\xf2\x05\xde\x3b\xf9\xf1\x93\x7f\x7e\xf2\xff\x01\x00\xfe\x1d\x78\xd7\xc9\x1c\x00\x00")
+
+func dataTranslationsJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataTranslationsJson,
+		"data/translations.json",
+	)
+}
+
+func dataTranslationsJson() (*asset, error) {
+	bytes, err := dataTranslationsJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/translations.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -212,10 +317,15 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"data/geo.json":           dataGeoJson,
-	"data/hotel_ratings.json": dataHotel_ratingsJson,
-	"data/hotels.json":        dataHotelsJson,
-	"data/inventory.json":     dataInventoryJson,
+	"data/allotments.json":     dataAllotmentsJson,
+	"data/exchange_rates.json": dataExchange_ratesJson,
+	"data/geo.json":            dataGeoJson,
+	"data/hotel_ratings.json":  dataHotel_ratingsJson,
+	"data/hotels.json":         dataHotelsJson,
+	"data/inventory.json":      dataInventoryJson,
+	"data/neighborhoods.json":  dataNeighborhoodsJson,
+	"data/taxes.json":          dataTaxesJson,
+	"data/translations.json":   dataTranslationsJson,
 }
 
 // AssetDir returns the file names below a certain
@@ -260,10 +370,15 @@ type bintree struct {
 
 var _bintree = &bintree{nil, map[string]*bintree{
 	"data": &bintree{nil, map[string]*bintree{
-		"geo.json":           &bintree{dataGeoJson, map[string]*bintree{}},
-		"hotel_ratings.json": &bintree{dataHotel_ratingsJson, map[string]*bintree{}},
-		"hotels.json":        &bintree{dataHotelsJson, map[string]*bintree{}},
-		"inventory.json":     &bintree{dataInventoryJson, map[string]*bintree{}},
+		"allotments.json":     &bintree{dataAllotmentsJson, map[string]*bintree{}},
+		"exchange_rates.json": &bintree{dataExchange_ratesJson, map[string]*bintree{}},
+		"geo.json":            &bintree{dataGeoJson, map[string]*bintree{}},
+		"hotel_ratings.json":  &bintree{dataHotel_ratingsJson, map[string]*bintree{}},
+		"hotels.json":         &bintree{dataHotelsJson, map[string]*bintree{}},
+		"inventory.json":      &bintree{dataInventoryJson, map[string]*bintree{}},
+		"neighborhoods.json":  &bintree{dataNeighborhoodsJson, map[string]*bintree{}},
+		"taxes.json":          &bintree{dataTaxesJson, map[string]*bintree{}},
+		"translations.json":   &bintree{dataTranslationsJson, map[string]*bintree{}},
 	}},
 }}
 
@@ -0,0 +1,16 @@
+// Package amenities lists the amenities a hotel profile can offer and
+// searches can filter on.
+package amenities
+
+// Names are the known amenities, in the order they are documented.
+var Names = []string{"wifi", "parking", "pets", "pool", "gym", "spa", "restaurant", "breakfast"}
+
+// Known reports whether name is one of Names.
+func Known(name string) bool {
+	for _, n := range Names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
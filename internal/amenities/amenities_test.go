@@ -0,0 +1,20 @@
+package amenities
+
+import "testing"
+
+func TestKnown(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		want bool
+	}{
+		{"wifi", true},
+		{"breakfast", true},
+		{"wify", false},
+		{"WiFi", false},
+		{"", false},
+	} {
+		if got := Known(tt.name); got != tt.want {
+			t.Fatalf("Known(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
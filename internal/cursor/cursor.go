@@ -0,0 +1,43 @@
+// Package cursor encodes the opaque pagination cursors handed out by the
+// services. A cursor records the offset of the next page and a key naming
+// the results it pages through; clients must treat it as an opaque token and
+// pass it back unchanged.
+package cursor
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+const prefix = "v1:"
+
+// ErrInvalid is returned when a cursor cannot be decoded.
+var ErrInvalid = errors.New("invalid cursor")
+
+// Encode returns an opaque cursor for the given offset into the results
+// identified by key.
+func Encode(offset int, key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(prefix + strconv.Itoa(offset) + ":" + key))
+}
+
+// Decode returns the offset and key recorded in c. An empty cursor decodes
+// to zero and an empty key.
+func Decode(c string) (int, string, error) {
+	if c == "" {
+		return 0, "", nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(c)
+	if err != nil || !strings.HasPrefix(string(b), prefix) {
+		return 0, "", ErrInvalid
+	}
+
+	off, key, _ := strings.Cut(strings.TrimPrefix(string(b), prefix), ":")
+	offset, err := strconv.Atoi(off)
+	if err != nil || offset < 0 {
+		return 0, "", ErrInvalid
+	}
+	return offset, key, nil
+}
@@ -0,0 +1,31 @@
+package cursor
+
+import "testing"
+
+func TestRoundTrip(t *testing.T) {
+	for _, tt := range []struct {
+		offset int
+		key    string
+	}{
+		{0, ""},
+		{1, "a"},
+		{20, "3f2a:9c"},
+		{12345, ""},
+	} {
+		offset, key, err := Decode(Encode(tt.offset, tt.key))
+		if err != nil {
+			t.Fatalf("Decode(Encode(%d, %q)) returned error: %v", tt.offset, tt.key, err)
+		}
+		if offset != tt.offset || key != tt.key {
+			t.Fatalf("Decode(Encode(%d, %q)) = %d, %q", tt.offset, tt.key, offset, key)
+		}
+	}
+}
+
+func TestDecodeRejectsGarbage(t *testing.T) {
+	for _, c := range []string{"!!", "MTA", Encode(-1, "")} {
+		if _, _, err := Decode(c); err != ErrInvalid {
+			t.Fatalf("Decode(%q) error = %v, want ErrInvalid", c, err)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+// Package dataload decodes the JSON data files embedded in the binary.
+// Unlike json.Unmarshal it rejects fields the target type does not declare
+// and reports every problem with the file, record index and field it was
+// found at, so bad data stops a service at startup instead of being served
+// half read.
+package dataload
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Error locates a problem in a data file.
+type Error struct {
+	File string
+	// Index of the record in the file's top level array, -1 when the
+	// problem is not tied to a record.
+	Index int
+	// Dotted path of the field within the record, if known.
+	Field string
+	Msg   string
+}
+
+func (e *Error) Error() string {
+	loc := e.File
+	if e.Index >= 0 {
+		loc += fmt.Sprintf("[%d]", e.Index)
+	}
+	if e.Field != "" {
+		loc += "." + e.Field
+	}
+	return loc + ": " + e.Msg
+}
+
+// Invalid reports a problem with a field of the record being checked.
+func Invalid(field, format string, args ...interface{}) error {
+	return &Error{Index: -1, Field: field, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Range checks that v lies within [min, max].
+func Range(field string, v, min, max float64) error {
+	if v < min || v > max {
+		return Invalid(field, "%v is out of range [%v, %v]", v, min, max)
+	}
+	return nil
+}
+
+// Required checks that v is not blank.
+func Required(field, v string) error {
+	if strings.TrimSpace(v) == "" {
+		return Invalid(field, "is required")
+	}
+	return nil
+}
+
+// Records decodes the JSON array in b, named file in errors, into a slice
+// of T. Each record is decoded strictly and then passed to check, if set,
+// to validate its values.
+func Records[T any](file string, b []byte, check func(r *T) error) ([]T, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return nil, &Error{File: file, Index: -1, Msg: "expected an array of records"}
+		}
+		return nil, &Error{File: file, Index: -1, Msg: jsonMsg(err)}
+	}
+
+	records := make([]T, len(raw))
+	for i, r := range raw {
+		if err := Strict(r, &records[i]); err != nil {
+			return nil, at(file, i, locateUnknown(err, r, reflect.TypeOf(records[i])))
+		}
+		if check == nil {
+			continue
+		}
+		if err := check(&records[i]); err != nil {
+			return nil, at(file, i, err)
+		}
+	}
+	return records, nil
+}
+
+// Decode strictly decodes the single JSON value in b, named file in
+// errors, into v. It is for files whose top level is an object rather than
+// an array of records.
+func Decode(file string, b []byte, v interface{}) error {
+	if err := Strict(b, v); err != nil {
+		return at(file, -1, locateUnknown(err, b, reflect.TypeOf(v)))
+	}
+	return nil
+}
+
+// Strict decodes a single JSON value from b into v, rejecting unknown
+// fields and trailing data.
+func Strict(b []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if dec.More() {
+		return errors.New("unexpected data after the JSON value")
+	}
+	return nil
+}
+
+// at places err at record i of file.
+func at(file string, i int, err error) error {
+	var e *Error
+	if errors.As(err, &e) {
+		return &Error{File: file, Index: i, Field: e.Field, Msg: e.Msg}
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return &Error{File: file, Index: i, Field: typeErr.Field, Msg: fmt.Sprintf("cannot be a JSON %s", typeErr.Value)}
+	}
+
+	// the decoder only reports unknown fields by message
+	msg := jsonMsg(err)
+	if name, ok := strings.CutPrefix(msg, "unknown field "); ok {
+		return &Error{File: file, Index: i, Field: strings.Trim(name, `"`), Msg: "unknown field"}
+	}
+	return &Error{File: file, Index: i, Msg: msg}
+}
+
+// locateUnknown turns the decoder's unknown field error for b, decoded
+// into a t, into an Error carrying the field's full path within the record.
+// The decoder only names the field itself, so the path is found by walking
+// b alongside t. Other errors are returned as is.
+func locateUnknown(err error, b []byte, t reflect.Type) error {
+	name, ok := strings.CutPrefix(jsonMsg(err), "unknown field ")
+	if !ok {
+		return err
+	}
+	var v interface{}
+	if json.Unmarshal(b, &v) != nil {
+		return err
+	}
+	if path := unknownPath(v, t, strings.Trim(name, `"`)); path != "" {
+		return &Error{Index: -1, Field: path, Msg: "unknown field"}
+	}
+	return err
+}
+
+// unknownPath returns the path within v of a key named name that t does
+// not declare, or "" if there is none.
+func unknownPath(v interface{}, t reflect.Type, name string) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			ft := t
+			switch t.Kind() {
+			case reflect.Map:
+				ft = t.Elem()
+			case reflect.Struct:
+				f, ok := jsonField(t, k)
+				if !ok {
+					if k == name {
+						return k
+					}
+					continue
+				}
+				ft = f.Type
+			default:
+				return ""
+			}
+			if p := unknownPath(v[k], ft, name); p != "" {
+				if strings.HasPrefix(p, "[") {
+					return k + p
+				}
+				return k + "." + p
+			}
+		}
+	case []interface{}:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return ""
+		}
+		for i, e := range v {
+			if p := unknownPath(e, t.Elem(), name); p != "" {
+				if strings.HasPrefix(p, "[") {
+					return fmt.Sprintf("[%d]%s", i, p)
+				}
+				return fmt.Sprintf("[%d].%s", i, p)
+			}
+		}
+	}
+	return ""
+}
+
+// jsonField returns the field of struct type t that encoding/json decodes
+// key into: the one named key exactly, else case-insensitively.
+func jsonField(t reflect.Type, key string) (reflect.StructField, bool) {
+	var fold *reflect.StructField
+	for _, f := range reflect.VisibleFields(t) {
+		tag := f.Tag.Get("json")
+		if !f.IsExported() || tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" {
+			if f.Anonymous && f.Type.Kind() == reflect.Struct {
+				// its fields are promoted into t
+				continue
+			}
+			name = f.Name
+		}
+		if name == key {
+			return f, true
+		}
+		if fold == nil && strings.EqualFold(name, key) {
+			fold = &f
+		}
+	}
+	if fold != nil {
+		return *fold, true
+	}
+	return reflect.StructField{}, false
+}
+
+func jsonMsg(err error) string {
+	return strings.TrimPrefix(err.Error(), "json: ")
+}
@@ -0,0 +1,58 @@
+package dataload
+
+import "testing"
+
+type record struct {
+	ID     string            `json:"id"`
+	Lat    float64           `json:"lat"`
+	Owner  *owner            `json:"owner"`
+	Nights []night           `json:"nights"`
+	Tags   map[string]*owner `json:"tags"`
+}
+
+type owner struct {
+	Name string `json:"name"`
+}
+
+type night struct {
+	Date  string `json:"date"`
+	Owner owner  `json:"owner"`
+}
+
+func checkRecord(r *record) error {
+	if err := Required("id", r.ID); err != nil {
+		return err
+	}
+	return Range("lat", r.Lat, -90, 90)
+}
+
+func TestRecordsDecodes(t *testing.T) {
+	records, err := Records("points.json", []byte(`[{"id": "1", "lat": 37.78}, {"id": "2"}]`), checkRecord)
+	if err != nil {
+		t.Fatalf("Records returned error: %v", err)
+	}
+	if len(records) != 2 || records[0].Lat != 37.78 || records[1].ID != "2" {
+		t.Fatalf("unexpected records: %v", records)
+	}
+}
+
+func TestRecordsLocatesErrors(t *testing.T) {
+	for _, tt := range []struct {
+		in, want string
+	}{
+		{`{}`, "points.json: expected an array of records"},
+		{`[{"id": "1"}, {"id": "2", "latitude": 1}]`, `points.json[1].latitude: unknown field`},
+		{`[{"id": "1", "owner": {"name": "a", "email": "b"}}]`, `points.json[0].owner.email: unknown field`},
+		{`[{"id": "1", "nights": [{"date": "x"}, {"date": "y", "owner": {"email": "b"}}]}]`, `points.json[0].nights[1].owner.email: unknown field`},
+		{`[{"id": "1", "tags": {"vip": {"email": "b"}}}]`, `points.json[0].tags.vip.email: unknown field`},
+		{`[{"ID": "1", "Owner": {"Name": "a", "email": "b"}}]`, `points.json[0].Owner.email: unknown field`},
+		{`[{"id": "1", "lat": "north"}]`, "points.json[0].lat: cannot be a JSON string"},
+		{`[{"id": "1", "lat": 91}]`, "points.json[0].lat: 91 is out of range [-90, 90]"},
+		{`[{"id": " "}]`, "points.json[0].id: is required"},
+	} {
+		_, err := Records("points.json", []byte(tt.in), checkRecord)
+		if err == nil || err.Error() != tt.want {
+			t.Fatalf("%s: error = %v, want %q", tt.in, err, tt.want)
+		}
+	}
+}
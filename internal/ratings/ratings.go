@@ -0,0 +1,48 @@
+// Package ratings loads the hotel ratings that search ranks by and the
+// frontend shows.
+package ratings
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/harlow/go-micro-services/data"
+	"github.com/harlow/go-micro-services/internal/dataload"
+)
+
+// Load loads hotel ratings from an embedded JSON file, exiting when it is
+// invalid.
+func Load(path string) map[string]float64 {
+	ratings, err := Parse(path, data.MustAsset(path))
+	if err != nil {
+		log.Fatalf("failed to load hotel ratings: %v", err)
+	}
+	return ratings
+}
+
+// Parse reads the hotel ratings in file, each between 0 and 5, keyed by
+// hotel id.
+func Parse(file string, b []byte) (map[string]float64, error) {
+	type row struct {
+		ID     string  `json:"id"`
+		Rating float64 `json:"rating"`
+	}
+	rows, err := dataload.Records(file, b, func(r *row) error {
+		if err := dataload.Required("id", r.ID); err != nil {
+			return err
+		}
+		return dataload.Range("rating", r.Rating, 0, 5)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ratings := make(map[string]float64, len(rows))
+	for i, r := range rows {
+		if _, ok := ratings[r.ID]; ok {
+			return nil, &dataload.Error{File: file, Index: i, Field: "id", Msg: fmt.Sprintf("hotel %s is rated twice", r.ID)}
+		}
+		ratings[r.ID] = r.Rating
+	}
+	return ratings, nil
+}
@@ -0,0 +1,35 @@
+package ratings
+
+import (
+	"testing"
+
+	"github.com/harlow/go-micro-services/data"
+)
+
+func TestParse(t *testing.T) {
+	if _, err := Parse("data/hotel_ratings.json", data.MustAsset("data/hotel_ratings.json")); err != nil {
+		t.Fatalf("embedded ratings are invalid: %v", err)
+	}
+
+	ratings, err := Parse("ratings.json", []byte(`[{"id": "1", "rating": 4.4}, {"id": "2", "rating": 5}]`))
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if ratings["1"] != 4.4 || ratings["2"] != 5 {
+		t.Fatalf("unexpected ratings: %v", ratings)
+	}
+
+	for _, tt := range []struct {
+		in, want string
+	}{
+		{`[{"id": "1", "rating": 44}]`, "ratings.json[0].rating: 44 is out of range [0, 5]"},
+		{`[{"id": "1", "stars": 4}]`, "ratings.json[0].stars: unknown field"},
+		{`[{"rating": 4}]`, "ratings.json[0].id: is required"},
+		{`[{"id": "1", "rating": 4}, {"id": "1", "rating": 3}]`, "ratings.json[1].id: hotel 1 is rated twice"},
+	} {
+		_, err := Parse("ratings.json", []byte(tt.in))
+		if err == nil || err.Error() != tt.want {
+			t.Fatalf("%s: error = %v, want %q", tt.in, err, tt.want)
+		}
+	}
+}
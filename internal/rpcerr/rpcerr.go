@@ -0,0 +1,120 @@
+// Package rpcerr builds gRPC status errors that carry structured details, so
+// the code and context of a failure survive every hop between services.
+package rpcerr
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// InvalidArgument returns an InvalidArgument error describing the offending
+// request field.
+func InvalidArgument(field, description string) error {
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("invalid %s: %s", field, description))
+	return withDetails(st, &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: description},
+		},
+	})
+}
+
+// NotFound returns a NotFound error for the named resource.
+func NotFound(resourceType, name, description string) error {
+	st := status.New(codes.NotFound, description)
+	return withDetails(st, &errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: name,
+		Description:  description,
+	})
+}
+
+// FailedPrecondition returns a FailedPrecondition error for a request the
+// system is not in a state to accept, such as booking a sold out night.
+// violationType is a short machine readable kind, e.g. "SOLD_OUT", and
+// subject names what the check failed on.
+func FailedPrecondition(violationType, subject, description string) error {
+	st := status.New(codes.FailedPrecondition, description)
+	return withDetails(st, &errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{
+			{Type: violationType, Subject: subject, Description: description},
+		},
+	})
+}
+
+// Unavailable returns an Unavailable error advising callers to retry after
+// the given delay.
+func Unavailable(message string, retryAfter time.Duration) error {
+	st := status.New(codes.Unavailable, message)
+	return withDetails(st, &errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryAfter),
+	})
+}
+
+// Wrap prefixes the message of err while keeping its status code and
+// details. Context errors become Canceled or DeadlineExceeded and any other
+// error without a status becomes Unknown.
+func Wrap(err error, prefix string) error {
+	if err == nil {
+		return nil
+	}
+
+	p := Convert(err).Proto()
+	p.Message = prefix + ": " + p.Message
+	return status.ErrorProto(p)
+}
+
+// Convert returns the status of err, mapping bare context errors onto
+// Canceled and DeadlineExceeded.
+func Convert(err error) *status.Status {
+	st := status.Convert(err)
+	if st.Code() == codes.Unknown {
+		st = status.FromContextError(err)
+	}
+	return st
+}
+
+// RetryDelay returns the retry delay attached to err, if any.
+func RetryDelay(err error) (time.Duration, bool) {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.RetryInfo); ok && info.RetryDelay != nil {
+			return info.RetryDelay.AsDuration(), true
+		}
+	}
+	return 0, false
+}
+
+// FieldViolations returns the bad request field violations attached to err.
+func FieldViolations(err error) []*errdetails.BadRequest_FieldViolation {
+	var out []*errdetails.BadRequest_FieldViolation
+	for _, d := range status.Convert(err).Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			out = append(out, br.FieldViolations...)
+		}
+	}
+	return out
+}
+
+// PreconditionViolations returns the precondition failures attached to err.
+func PreconditionViolations(err error) []*errdetails.PreconditionFailure_Violation {
+	var out []*errdetails.PreconditionFailure_Violation
+	for _, d := range status.Convert(err).Details() {
+		if pf, ok := d.(*errdetails.PreconditionFailure); ok {
+			out = append(out, pf.Violations...)
+		}
+	}
+	return out
+}
+
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
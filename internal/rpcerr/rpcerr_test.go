@@ -0,0 +1,49 @@
+package rpcerr
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWrapKeepsCodeAndDetails(t *testing.T) {
+	err := Wrap(Unavailable("rate service draining", 3*time.Second), "rates")
+
+	st := status.Convert(err)
+	if st.Code() != codes.Unavailable {
+		t.Fatalf("code = %v, want Unavailable", st.Code())
+	}
+	if st.Message() != "rates: rate service draining" {
+		t.Fatalf("message = %q", st.Message())
+	}
+	if d, ok := RetryDelay(err); !ok || d != 3*time.Second {
+		t.Fatalf("retry delay = %v, %v, want 3s", d, ok)
+	}
+}
+
+func TestWrapMapsContextErrors(t *testing.T) {
+	if code := status.Code(Wrap(context.DeadlineExceeded, "nearby")); code != codes.DeadlineExceeded {
+		t.Fatalf("code = %v, want DeadlineExceeded", code)
+	}
+}
+
+func TestInvalidArgumentFieldViolations(t *testing.T) {
+	fvs := FieldViolations(InvalidArgument("inDate", "expected YYYY-MM-DD"))
+	if len(fvs) != 1 || fvs[0].Field != "inDate" {
+		t.Fatalf("unexpected field violations: %v", fvs)
+	}
+}
+
+func TestFailedPreconditionViolations(t *testing.T) {
+	err := Wrap(FailedPrecondition("SOLD_OUT", "1/KNG/2015-04-10", "sold out on 2015-04-10"), "hold")
+	if code := status.Code(err); code != codes.FailedPrecondition {
+		t.Fatalf("code = %v, want FailedPrecondition", code)
+	}
+	vs := PreconditionViolations(err)
+	if len(vs) != 1 || vs[0].Type != "SOLD_OUT" || vs[0].Subject != "1/KNG/2015-04-10" {
+		t.Fatalf("unexpected violations: %v", vs)
+	}
+}
@@ -0,0 +1,16 @@
+package runtime
+
+import (
+	"context"
+	"time"
+)
+
+// WithBudget bounds ctx by a per-call deadline budget. The deadline is
+// propagated to gRPC calls made with the returned context, and an earlier
+// deadline already set on ctx still wins. A zero budget leaves ctx unbounded.
+func WithBudget(ctx context.Context, budget time.Duration) (context.Context, context.CancelFunc) {
+	if budget <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, budget)
+}
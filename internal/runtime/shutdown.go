@@ -7,13 +7,34 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync/atomic"
 	"syscall"
 	"time"
 
+	"github.com/harlow/go-micro-services/internal/rpcerr"
 	"google.golang.org/grpc"
 )
 
-const shutdownTimeout = 5 * time.Second
+const (
+	shutdownTimeout = 5 * time.Second
+	// drainPeriod is how long a stopping gRPC server turns new calls away
+	// with a retry hint, giving clients time to move to another server,
+	// before it stops accepting connections.
+	drainPeriod = time.Second
+)
+
+// draining is set once the process's gRPC server starts shutting down.
+var draining atomic.Bool
+
+// RejectWhileDraining is a unary server interceptor failing calls made
+// after the server started shutting down with Unavailable and a hint to
+// retry once another server can take them. Calls already in flight finish.
+func RejectWhileDraining(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if draining.Load() {
+		return nil, rpcerr.Unavailable("server is shutting down", drainPeriod)
+	}
+	return handler(ctx, req)
+}
 
 // ServeHTTPGracefully starts an HTTP server and handles SIGINT/SIGTERM shutdown.
 func ServeHTTPGracefully(addr string, handler http.Handler) error {
@@ -46,7 +67,9 @@ func ServeHTTPGracefully(addr string, handler http.Handler) error {
 	}
 }
 
-// ServeGRPCGracefully starts a gRPC server and handles SIGINT/SIGTERM shutdown.
+// ServeGRPCGracefully starts a gRPC server and handles SIGINT/SIGTERM
+// shutdown. On a signal it first drains for drainPeriod, turning new calls
+// away through RejectWhileDraining, then waits for the calls in flight.
 func ServeGRPCGracefully(lis net.Listener, srv *grpc.Server) error {
 	errCh := make(chan error, 1)
 	go func() {
@@ -63,6 +86,9 @@ func ServeGRPCGracefully(lis net.Listener, srv *grpc.Server) error {
 		}
 		return err
 	case <-ctx.Done():
+		draining.Store(true)
+		time.Sleep(drainPeriod)
+
 		done := make(chan struct{})
 		go func() {
 			srv.GracefulStop()
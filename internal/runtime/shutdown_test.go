@@ -0,0 +1,30 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harlow/go-micro-services/internal/rpcerr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRejectWhileDraining(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	info := &grpc.UnaryServerInfo{FullMethod: "/geo.Geo/Nearby"}
+
+	if res, err := RejectWhileDraining(context.Background(), nil, info, handler); err != nil || res != "ok" {
+		t.Fatalf("got %v, %v before draining, want the handler's result", res, err)
+	}
+
+	draining.Store(true)
+	defer draining.Store(false)
+	_, err := RejectWhileDraining(context.Background(), nil, info, handler)
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("error code = %v, want Unavailable", status.Code(err))
+	}
+	if d, ok := rpcerr.RetryDelay(err); !ok || d != drainPeriod {
+		t.Fatalf("retry delay = %v, %v, want %v", d, ok, drainPeriod)
+	}
+}
@@ -0,0 +1,115 @@
+package frontend
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// profileProperties maps the properties of a /hotels feature that are read
+// from the hotel profile to the profile fields they are built from.
+var profileProperties = map[string][]string{
+	"name":         {"name"},
+	"phone_number": {"phoneNumber"},
+	"description":  {"description"},
+	"address_line": {"address.streetNumber", "address.streetName", "address.city", "address.state", "address.postalCode"},
+	"city":         {"address.city"},
+	"state":        {"address.state"},
+	"country":      {"address.country"},
+	"postal_code":  {"address.postalCode"},
+	"logo_url":     {"images"},
+	"locale":       {"locale"},
+	"amenities":    {"amenities"},
+	"policies":     {"policies"},
+}
+
+// searchProperties are the properties of a /hotels feature that come from
+// search and the ratings rather than the profile.
+var searchProperties = []string{"rating", "rate_plans", "score", "distance_km"}
+
+// geometryPaths are the profile fields every feature needs for its
+// geometry, whatever the properties asked for.
+var geometryPaths = []string{"address.latitude", "address.longitude", "address.lat", "address.lon"}
+
+// parseFields reads the fields query param, a comma separated list of
+// feature properties such as fields=name,rating. It returns the properties
+// to keep, nil meaning all of them, and the mask asking the profile
+// service for only the profile fields they are built from.
+func parseFields(r *http.Request) (map[string]bool, *fieldmaskpb.FieldMask, error) {
+	v := r.URL.Query().Get("fields")
+	if strings.TrimSpace(v) == "" {
+		return nil, profileMask(nil), nil
+	}
+
+	props := make(map[string]bool)
+	for _, name := range strings.Split(v, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if _, ok := profileProperties[name]; !ok && !isSearchProperty(name) {
+			return nil, nil, fmt.Errorf("invalid fields, unknown field %q; expected any of %s", name, strings.Join(propertyNames(), ", "))
+		}
+		props[name] = true
+	}
+	return props, profileMask(props), nil
+}
+
+// profileMask returns the profile fields needed for the given properties,
+// or for every property when props is nil.
+func profileMask(props map[string]bool) *fieldmaskpb.FieldMask {
+	seen := make(map[string]bool)
+	for _, p := range geometryPaths {
+		seen[p] = true
+	}
+	for name, paths := range profileProperties {
+		if props != nil && !props[name] {
+			continue
+		}
+		for _, p := range paths {
+			seen[p] = true
+		}
+	}
+
+	mask := &fieldmaskpb.FieldMask{}
+	for p := range seen {
+		mask.Paths = append(mask.Paths, p)
+	}
+	sort.Strings(mask.Paths)
+	return mask
+}
+
+// selectProperties removes the properties of a feature not in props. A nil
+// props keeps them all.
+func selectProperties(properties map[string]interface{}, props map[string]bool) map[string]interface{} {
+	if props == nil {
+		return properties
+	}
+	for name := range properties {
+		if !props[name] {
+			delete(properties, name)
+		}
+	}
+	return properties
+}
+
+func isSearchProperty(name string) bool {
+	for _, p := range searchProperties {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+func propertyNames() []string {
+	names := append([]string(nil), searchProperties...)
+	for name := range profileProperties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
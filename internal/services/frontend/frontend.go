@@ -5,32 +5,66 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
-	"github.com/harlow/go-micro-services/data"
+	"github.com/harlow/go-micro-services/internal/ratings"
+	"github.com/harlow/go-micro-services/internal/rpcerr"
 	runtime "github.com/harlow/go-micro-services/internal/runtime"
+	geo "github.com/harlow/go-micro-services/internal/services/geo/proto"
 	profile "github.com/harlow/go-micro-services/internal/services/profile/proto"
+	rate "github.com/harlow/go-micro-services/internal/services/rate/proto"
+	reservation "github.com/harlow/go-micro-services/internal/services/reservation/proto"
 	search "github.com/harlow/go-micro-services/internal/services/search/proto"
 	"github.com/harlow/go-micro-services/internal/trace"
+	"golang.org/x/text/language"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
+// Default search location (downtown San Francisco) used when the caller
+// doesn't supply coordinates.
+const (
+	defaultLat  = 37.7749
+	defaultLon  = -122.4194
+	maxRadiusKm = 50
+	maxPageSize = 100
+)
+
+// Timeouts holds the deadline budget for each upstream call. A zero budget
+// leaves the call bounded only by the incoming request.
+type Timeouts struct {
+	Search      time.Duration
+	Profile     time.Duration
+	Rate        time.Duration
+	Reservation time.Duration
+}
+
 // New returns a new server
-func New(searchconn, profileconn *grpc.ClientConn) *Frontend {
+func New(searchconn, profileconn, rateconn, reservationconn *grpc.ClientConn, timeouts Timeouts) *Frontend {
 	return &Frontend{
-		searchClient:  search.NewSearchClient(searchconn),
-		profileClient: profile.NewProfileClient(profileconn),
-		ratings:       loadRatings("data/hotel_ratings.json"),
+		searchClient:      search.NewSearchClient(searchconn),
+		profileClient:     profile.NewProfileClient(profileconn),
+		rateClient:        rate.NewRateClient(rateconn),
+		reservationClient: reservation.NewReservationClient(reservationconn),
+		ratings:           ratings.Load("data/hotel_ratings.json"),
+		timeouts:          timeouts,
+		idempotency:       newIdempotencyStore(idempotencyTTL, maxIdempotencyEntries),
 	}
 }
 
 // Frontend implements frontend service
 type Frontend struct {
-	searchClient  search.SearchClient
-	profileClient profile.ProfileClient
-	ratings       map[string]float64
+	searchClient      search.SearchClient
+	profileClient     profile.ProfileClient
+	rateClient        rate.RateClient
+	reservationClient reservation.ReservationClient
+	ratings           map[string]float64
+	timeouts          Timeouts
+	idempotency       *idempotencyStore
 }
 
 // Run the server
@@ -38,6 +72,12 @@ func (s *Frontend) Run(port int) error {
 	mux := trace.NewServeMux()
 	mux.Handle("/", http.FileServer(http.Dir("public")))
 	mux.Handle("/hotels", http.HandlerFunc(s.searchHandler))
+	mux.Handle("GET /hotels/{id}", http.HandlerFunc(s.hotelHandler))
+	mux.Handle("POST /reservations", http.HandlerFunc(s.createReservationHandler))
+	mux.Handle("GET /reservations/{id}", http.HandlerFunc(s.getReservationHandler))
+	mux.Handle("DELETE /reservations/{id}", http.HandlerFunc(s.cancelReservationHandler))
+	mux.Handle("OPTIONS /reservations", http.HandlerFunc(reservationsPreflightHandler))
+	mux.Handle("OPTIONS /reservations/{id}", http.HandlerFunc(reservationsPreflightHandler))
 	mux.Handle("/healthz", http.HandlerFunc(s.healthHandler))
 	mux.Handle("/readyz", http.HandlerFunc(s.readyHandler))
 
@@ -54,36 +94,220 @@ func (s *Frontend) searchHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// search for best hotels
-	// TODO(hw): allow lat/lon from input params
-	searchResp, err := s.searchClient.Nearby(ctx, &search.NearbyRequest{
-		Lat:     37.7749,
-		Lon:     -122.4194,
-		InDate:  inDate,
-		OutDate: outDate,
+	list, err := parseListParams(r)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+		return
+	}
+
+	currency, err := parseCurrency(r)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+		return
+	}
+
+	locale, err := parseLocale(r)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+		return
+	}
+	w.Header().Add("Vary", "Accept-Language")
+
+	props, mask, err := parseFields(r)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+		return
+	}
+
+	// search for best hotels, either by city name or around a location
+	searchCtx, cancel := runtime.WithBudget(ctx, s.timeouts.Search)
+	defer cancel()
+
+	var searchResp *search.SearchResult
+	if city := strings.TrimSpace(r.URL.Query().Get("city")); city != "" {
+		if hasLocationParams(r) {
+			writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "city cannot be combined with lat, lon, radius, nearest or bbox")
+			return
+		}
+		if list.sort == "distance" {
+			writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "city search cannot sort by distance")
+			return
+		}
+
+		searchResp, err = s.searchClient.City(searchCtx, &search.CityRequest{
+			City:      city,
+			InDate:    inDate,
+			OutDate:   outDate,
+			Sort:      list.sort,
+			Order:     list.order,
+			Weights:   list.weights,
+			Limit:     list.limit,
+			Cursor:    list.cursor,
+			RatePlans: list.ratePlans,
+			Amenities: list.amenities,
+			Currency:  currency,
+		})
+	} else if bbox := r.URL.Query().Get("bbox"); bbox != "" {
+		if hasPointParams(r) {
+			writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "bbox cannot be combined with lat, lon, radius or nearest")
+			return
+		}
+		ne, sw, perr := parseBBox(bbox)
+		if perr != nil {
+			writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", perr.Error())
+			return
+		}
+
+		searchResp, err = s.searchClient.Within(searchCtx, &search.WithinRequest{
+			NorthEast: ne,
+			SouthWest: sw,
+			InDate:    inDate,
+			OutDate:   outDate,
+			Sort:      list.sort,
+			Order:     list.order,
+			Weights:   list.weights,
+			Limit:     list.limit,
+			Cursor:    list.cursor,
+			RatePlans: list.ratePlans,
+			Amenities: list.amenities,
+			Currency:  currency,
+		})
+	} else {
+		lat, lon, radius, perr := parseLocation(r)
+		if perr != nil {
+			writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", perr.Error())
+			return
+		}
+		nearest, perr := parseNearest(r)
+		if perr != nil {
+			writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", perr.Error())
+			return
+		}
+
+		searchResp, err = s.searchClient.Nearby(searchCtx, &search.NearbyRequest{
+			Lat:       float32(lat),
+			Lon:       float32(lon),
+			Latitude:  &lat,
+			Longitude: &lon,
+			RadiusKm:  radius,
+			Nearest:   nearest,
+			InDate:    inDate,
+			OutDate:   outDate,
+			Sort:      list.sort,
+			Order:     list.order,
+			Weights:   list.weights,
+			Limit:     list.limit,
+			Cursor:    list.cursor,
+			RatePlans: list.ratePlans,
+			Amenities: list.amenities,
+			Currency:  currency,
+		})
+	}
+	if err != nil {
+		writeUpstreamError(w, err, "search")
+		return
+	}
+
+	// hotel profiles; when the profile service is slow or down, fall back
+	// to a partial response built from what search returned
+	profileCtx, cancel := runtime.WithBudget(ctx, s.timeouts.Profile)
+	defer cancel()
+	profileResp, err := s.profileClient.GetProfiles(profileCtx, &profile.Request{
+		HotelIds:  searchResp.HotelIds,
+		Locale:    locale,
+		FieldMask: mask,
 	})
+	partial := false
+	if err != nil {
+		switch rpcerr.Convert(err).Code() {
+		case codes.DeadlineExceeded, codes.Unavailable:
+			log.Printf("profile service degraded, serving partial results: %v", err)
+			profileResp, partial = &profile.Result{}, true
+		default:
+			writeUpstreamError(w, err, "profile")
+			return
+		}
+	}
+
+	resp := geoJSONResponse(searchResp.Hotels, profileResp.Hotels, s.ratings, props)
+	resp["partial"] = partial
+	resp["truncated"] = searchResp.Truncated
+	if searchResp.Ranker != "" {
+		resp["ranking"] = searchResp.Ranker
+	}
+	if searchResp.NextCursor != "" {
+		resp["next_cursor"] = searchResp.NextCursor
+	}
+	if len(searchResp.Exclusions) > 0 {
+		resp["excluded"] = exclusionsJSON(searchResp.Exclusions)
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Frontend) hotelHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	ctx := r.Context()
+	hotelID := r.PathValue("id")
+
+	inDate, outDate, err := parseDateRange(r)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+		return
+	}
+
+	currency, err := parseCurrency(r)
 	if err != nil {
-		writeJSONError(w, http.StatusBadGateway, "UPSTREAM_ERROR", "search service unavailable")
+		writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
 		return
 	}
 
-	// grab locale from query params or default to en
-	locale := r.URL.Query().Get("locale")
-	if locale == "" {
-		locale = "en"
+	locale, err := parseLocale(r)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+		return
 	}
+	w.Header().Add("Vary", "Accept-Language")
 
-	// hotel profiles
-	profileResp, err := s.profileClient.GetProfiles(ctx, &profile.Request{
-		HotelIds: searchResp.HotelIds,
+	profileCtx, cancel := runtime.WithBudget(ctx, s.timeouts.Profile)
+	defer cancel()
+	profileResp, err := s.profileClient.GetProfiles(profileCtx, &profile.Request{
+		HotelIds: []string{hotelID},
 		Locale:   locale,
 	})
 	if err != nil {
-		writeJSONError(w, http.StatusBadGateway, "UPSTREAM_ERROR", "profile service unavailable")
+		writeUpstreamError(w, err, "profile")
+		return
+	}
+	if len(profileResp.Hotels) == 0 {
+		writeJSONError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("hotel %q not found", hotelID))
 		return
 	}
 
-	writeJSON(w, http.StatusOK, geoJSONResponse(profileResp.Hotels, s.ratings))
+	rateCtx, cancel := runtime.WithBudget(ctx, s.timeouts.Rate)
+	defer cancel()
+	rateResp, err := s.rateClient.GetRates(rateCtx, &rate.Request{
+		HotelIds:  []string{hotelID},
+		InDate:    inDate,
+		OutDate:   outDate,
+		RateCodes: parseCodes(r, "rate_code"),
+		RoomCodes: parseCodes(r, "room_code"),
+		Currency:  currency,
+	})
+	if err != nil {
+		writeUpstreamError(w, err, "rate")
+		return
+	}
+
+	h := profileResp.Hotels[0]
+	if h.Locale != "" {
+		w.Header().Set("Content-Language", h.Locale)
+	}
+	resp := hotelResponse(h, s.ratings, rateResp.RatePlans)
+	if len(rateResp.Exclusions) > 0 {
+		resp["excluded"] = exclusionsJSON(rateResp.Exclusions)
+	}
+	writeJSON(w, http.StatusOK, resp)
 }
 
 func (s *Frontend) healthHandler(w http.ResponseWriter, _ *http.Request) {
@@ -94,11 +318,14 @@ func (s *Frontend) readyHandler(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
 	defer cancel()
 
+	lat, lon := defaultLat, defaultLon
 	_, err := s.searchClient.Nearby(ctx, &search.NearbyRequest{
-		Lat:     37.7749,
-		Lon:     -122.4194,
-		InDate:  "2015-04-09",
-		OutDate: "2015-04-10",
+		Lat:       float32(lat),
+		Lon:       float32(lon),
+		Latitude:  &lat,
+		Longitude: &lon,
+		InDate:    "2015-04-09",
+		OutDate:   "2015-04-10",
 	})
 	if err != nil {
 		writeJSONError(w, http.StatusServiceUnavailable, "NOT_READY", "search dependency is not ready")
@@ -123,24 +350,284 @@ func parseDateRange(r *http.Request) (string, string, error) {
 	if inDate == "" || outDate == "" {
 		return "", "", fmt.Errorf("missing required query params: inDate and outDate")
 	}
+	if err := validateDateRange("inDate", inDate, "outDate", outDate); err != nil {
+		return "", "", err
+	}
+	return inDate, outDate, nil
+}
 
+// validateDateRange checks a stay runs from inDate to a later outDate at
+// most 30 days away. inName and outName are the names the caller gave the
+// dates, used in errors.
+func validateDateRange(inName, inDate, outName, outDate string) error {
 	const dateFmt = "2006-01-02"
 	inParsed, err := time.Parse(dateFmt, inDate)
 	if err != nil {
-		return "", "", fmt.Errorf("invalid inDate format, expected YYYY-MM-DD")
+		return fmt.Errorf("invalid %s format, expected YYYY-MM-DD", inName)
 	}
 	outParsed, err := time.Parse(dateFmt, outDate)
 	if err != nil {
-		return "", "", fmt.Errorf("invalid outDate format, expected YYYY-MM-DD")
+		return fmt.Errorf("invalid %s format, expected YYYY-MM-DD", outName)
 	}
 	if !outParsed.After(inParsed) {
-		return "", "", fmt.Errorf("outDate must be after inDate")
+		return fmt.Errorf("%s must be after %s", outName, inName)
 	}
 	if outParsed.Sub(inParsed) > 30*24*time.Hour {
-		return "", "", fmt.Errorf("date range cannot exceed 30 days")
+		return fmt.Errorf("date range cannot exceed 30 days")
 	}
+	return nil
+}
 
-	return inDate, outDate, nil
+// parseLocation reads the optional lat, lon and radius query params. lat and
+// lon must be given together; when both are absent the default location is
+// used. A zero radius leaves the choice to the search service.
+func parseLocation(r *http.Request) (float64, float64, float32, error) {
+	q := r.URL.Query()
+	latStr, lonStr, radiusStr := q.Get("lat"), q.Get("lon"), q.Get("radius")
+
+	lat, lon := defaultLat, defaultLon
+	if latStr != "" || lonStr != "" {
+		if latStr == "" || lonStr == "" {
+			return 0, 0, 0, fmt.Errorf("lat and lon must be provided together")
+		}
+
+		var err error
+		lat, err = parseFloatParam("lat", latStr)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		lon, err = parseFloatParam("lon", lonStr)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		if lat < -90 || lat > 90 {
+			return 0, 0, 0, fmt.Errorf("lat must be between -90 and 90")
+		}
+		if lon < -180 || lon > 180 {
+			return 0, 0, 0, fmt.Errorf("lon must be between -180 and 180")
+		}
+	}
+
+	var radius float64
+	if radiusStr != "" {
+		var err error
+		radius, err = parseFloatParam("radius", radiusStr)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		if radius <= 0 || radius > maxRadiusKm {
+			return 0, 0, 0, fmt.Errorf("radius must be greater than 0 and at most %d km", maxRadiusKm)
+		}
+	}
+
+	return lat, lon, float32(radius), nil
+}
+
+// parseNearest reads the number of closest hotels to search for, which
+// replaces the radius.
+func parseNearest(r *http.Request) (int32, error) {
+	q := r.URL.Query()
+	v := q.Get("nearest")
+	if v == "" {
+		return 0, nil
+	}
+	if q.Get("radius") != "" {
+		return 0, fmt.Errorf("nearest cannot be combined with radius")
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 1 || n > maxPageSize {
+		return 0, fmt.Errorf("nearest must be an integer between 1 and %d", maxPageSize)
+	}
+	return int32(n), nil
+}
+
+// parseLocale reads the locale query param. Without one it takes the most
+// preferred language of the Accept-Language header, and defaults to en.
+func parseLocale(r *http.Request) (string, error) {
+	if locale := r.URL.Query().Get("locale"); locale != "" {
+		if _, err := language.Parse(locale); err != nil {
+			return "", fmt.Errorf("invalid locale, expected a BCP 47 language tag such as fr-CA")
+		}
+		return locale, nil
+	}
+
+	// tags come sorted by preference; a malformed header is ignored
+	tags, _, err := language.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+	if err == nil {
+		for _, tag := range tags {
+			if base, _ := tag.Base(); tag != language.Und && base.String() != "mul" {
+				return tag.String(), nil
+			}
+		}
+	}
+	return "en", nil
+}
+
+type listParams struct {
+	sort      string
+	order     string
+	weights   *search.Weights
+	limit     int32
+	cursor    string
+	ratePlans *search.RatePlanFilter
+	amenities []string
+}
+
+func parseListParams(r *http.Request) (listParams, error) {
+	q := r.URL.Query()
+	p := listParams{
+		sort:   q.Get("sort"),
+		order:  q.Get("order"),
+		cursor: q.Get("cursor"),
+	}
+
+	switch p.sort {
+	case "", "distance", "price", "rating", "weighted":
+	default:
+		return listParams{}, fmt.Errorf("invalid sort, expected distance, price, rating or weighted")
+	}
+
+	if v := q.Get("weights"); v != "" {
+		if p.sort != "weighted" {
+			return listParams{}, fmt.Errorf("weights require sort=weighted")
+		}
+		w, err := parseWeights(v)
+		if err != nil {
+			return listParams{}, err
+		}
+		p.weights = w
+	}
+
+	switch p.order {
+	case "", "asc", "desc":
+	default:
+		return listParams{}, fmt.Errorf("invalid order, expected asc or desc")
+	}
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > maxPageSize {
+			return listParams{}, fmt.Errorf("limit must be an integer between 1 and %d", maxPageSize)
+		}
+		p.limit = int32(limit)
+	}
+
+	p.ratePlans = &search.RatePlanFilter{
+		RateCodes: parseCodes(r, "rate_code"),
+		RoomCodes: parseCodes(r, "room_code"),
+	}
+	if v := q.Get("all_rate_plans"); v != "" {
+		all, err := strconv.ParseBool(v)
+		if err != nil {
+			return listParams{}, fmt.Errorf("invalid all_rate_plans, expected true or false")
+		}
+		p.ratePlans.All = all
+	}
+
+	for _, a := range parseCodes(r, "amenities") {
+		p.amenities = append(p.amenities, strings.ToLower(a))
+	}
+
+	return p, nil
+}
+
+// parseCurrency reads the optional ISO 4217 currency code to quote rates
+// in. Whether the rate service supports it is left to the rate service.
+func parseCurrency(r *http.Request) (string, error) {
+	v := strings.ToUpper(strings.TrimSpace(r.URL.Query().Get("currency")))
+	if v == "" {
+		return "", nil
+	}
+	if len(v) != 3 || strings.Trim(v, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return "", fmt.Errorf("invalid currency, expected a three letter ISO 4217 code")
+	}
+	return v, nil
+}
+
+// parseCodes reads a comma separated list of codes from the named query
+// parameter, such as rate_code=RACK,AAA. Blank entries are dropped.
+func parseCodes(r *http.Request, name string) []string {
+	var codes []string
+	for _, code := range strings.Split(r.URL.Query().Get(name), ",") {
+		if code = strings.TrimSpace(code); code != "" {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// parseWeights reads weights for the weighted ranker in the form
+// "distance:2,price:1,rating:1". Signals left out weigh nothing.
+func parseWeights(v string) (*search.Weights, error) {
+	w := &search.Weights{}
+	for _, pair := range strings.Split(v, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		weight, err := strconv.ParseFloat(value, 64)
+		if !ok || err != nil || weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return nil, fmt.Errorf("invalid weights, expected name:weight pairs with non-negative weights")
+		}
+		switch name {
+		case "distance":
+			w.Distance = weight
+		case "price":
+			w.Price = weight
+		case "rating":
+			w.Rating = weight
+		default:
+			return nil, fmt.Errorf("invalid weights, unknown signal %q", name)
+		}
+	}
+	return w, nil
+}
+
+// parseBBox reads a viewport in the "west,south,east,north" order used by
+// GeoJSON and Leaflet's toBBoxString. A west edge east of the east edge
+// crosses the antimeridian.
+func parseBBox(v string) (*geo.Location, *geo.Location, error) {
+	parts := strings.Split(v, ",")
+	if len(parts) != 4 {
+		return nil, nil, fmt.Errorf("invalid bbox, expected west,south,east,north")
+	}
+
+	var edges [4]float64
+	for i, part := range parts {
+		f, err := parseFloatParam("bbox", strings.TrimSpace(part))
+		if err != nil {
+			return nil, nil, err
+		}
+		edges[i] = f
+	}
+
+	west, south, east, north := edges[0], edges[1], edges[2], edges[3]
+	if south < -90 || north > 90 || south > north {
+		return nil, nil, fmt.Errorf("invalid bbox, latitudes must be between -90 and 90 with south below north")
+	}
+	if west < -180 || west > 180 || east < -180 || east > 180 {
+		return nil, nil, fmt.Errorf("invalid bbox, longitudes must be between -180 and 180")
+	}
+
+	return &geo.Location{Lat: north, Lon: east}, &geo.Location{Lat: south, Lon: west}, nil
+}
+
+// hasLocationParams reports whether any of the location based search params
+// are set.
+func hasLocationParams(r *http.Request) bool {
+	return hasPointParams(r) || r.URL.Query().Get("bbox") != ""
+}
+
+// hasPointParams reports whether any of the params of a search around a
+// single point are set.
+func hasPointParams(r *http.Request) bool {
+	q := r.URL.Query()
+	return q.Get("lat") != "" || q.Get("lon") != "" || q.Get("radius") != "" || q.Get("nearest") != ""
+}
+
+func parseFloatParam(name, value string) (float64, error) {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("invalid %s, expected a number", name)
+	}
+	return v, nil
 }
 
 func writeJSON(w http.ResponseWriter, status int, payload interface{}) {
@@ -160,25 +647,74 @@ func writeJSONError(w http.ResponseWriter, status int, code, message string) {
 	})
 }
 
-func loadRatings(path string) map[string]float64 {
-	file := data.MustAsset(path)
-
-	var rows []struct {
-		ID     string  `json:"id"`
-		Rating float64 `json:"rating"`
+// writeUpstreamError translates an error from an upstream gRPC service into
+// an HTTP error with a stable error code. Transient failures carry a
+// Retry-After header and are flagged as retryable.
+func writeUpstreamError(w http.ResponseWriter, err error, service string) {
+	st := rpcerr.Convert(err)
+
+	var (
+		httpStatus int
+		code       string
+		message    = st.Message()
+		retryable  bool
+	)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		httpStatus, code = http.StatusBadRequest, "INVALID_ARGUMENT"
+	case codes.NotFound:
+		httpStatus, code = http.StatusNotFound, "NOT_FOUND"
+	case codes.FailedPrecondition:
+		httpStatus, code = http.StatusConflict, "FAILED_PRECONDITION"
+	case codes.Unavailable:
+		httpStatus, code, retryable = http.StatusServiceUnavailable, "UNAVAILABLE", true
+		message = service + " service unavailable"
+	case codes.DeadlineExceeded:
+		httpStatus, code, retryable = http.StatusGatewayTimeout, "DEADLINE_EXCEEDED", true
+		message = service + " service timed out"
+	default:
+		log.Printf("%s service error: %v", service, err)
+		httpStatus, code = http.StatusBadGateway, "UPSTREAM_ERROR"
+		message = service + " service error"
 	}
 
-	if err := json.Unmarshal(file, &rows); err != nil {
-		log.Printf("failed to load hotel ratings from %s: %v", path, err)
-		return map[string]float64{}
+	body := map[string]interface{}{
+		"code":      code,
+		"message":   message,
+		"retryable": retryable,
 	}
-
-	ratings := make(map[string]float64, len(rows))
-	for _, row := range rows {
-		ratings[row.ID] = row.Rating
+	if retryable {
+		delay, ok := rpcerr.RetryDelay(err)
+		if !ok || delay < time.Second {
+			delay = time.Second
+		}
+		seconds := int(math.Ceil(delay.Seconds()))
+		w.Header().Set("Retry-After", strconv.Itoa(seconds))
+		body["retry_after_seconds"] = seconds
+	}
+	if fvs := rpcerr.FieldViolations(err); len(fvs) > 0 {
+		violations := make([]interface{}, 0, len(fvs))
+		for _, fv := range fvs {
+			violations = append(violations, map[string]string{
+				"field":       fv.Field,
+				"description": fv.Description,
+			})
+		}
+		body["field_violations"] = violations
+	}
+	if pvs := rpcerr.PreconditionViolations(err); len(pvs) > 0 {
+		violations := make([]interface{}, 0, len(pvs))
+		for _, pv := range pvs {
+			violations = append(violations, map[string]string{
+				"type":        pv.Type,
+				"subject":     pv.Subject,
+				"description": pv.Description,
+			})
+		}
+		body["precondition_violations"] = violations
 	}
 
-	return ratings
+	writeJSON(w, httpStatus, map[string]interface{}{"error": body})
 }
 
 func logoURL(images []*profile.Image) string {
@@ -215,20 +751,104 @@ func formatAddress(addr *profile.Address) string {
 	return strings.Join(clean, ", ")
 }
 
+func ratePlansJSON(plans []*rate.RatePlan) []interface{} {
+	out := make([]interface{}, 0, len(plans))
+	for _, p := range plans {
+		rt := p.GetRoomType()
+		plan := map[string]interface{}{
+			"code":                 p.Code,
+			"in_date":              p.InDate,
+			"out_date":             p.OutDate,
+			"room_code":            rt.GetCode(),
+			"room_description":     rt.GetRoomDescription(),
+			"currency":             rt.GetCurrency(),
+			"bookable_rate":        rt.GetBookableRate(),
+			"total_rate":           rt.GetTotalRate(),
+			"total_rate_inclusive": rt.GetTotalRateInclusive(),
+			"average_nightly_rate": rt.GetAverageNightlyRate(),
+			"nights":               rt.GetNights(),
+			"charges":              chargesJSON(rt.GetCharges()),
+		}
+		if n := rt.GetRoomsLeft(); n > 0 {
+			plan["rooms_left"] = n
+		}
+		if o := rt.GetOriginal(); o != nil {
+			plan["exchange_rate"] = rt.GetExchangeRate()
+			plan["original"] = map[string]interface{}{
+				"currency":             o.GetCurrency(),
+				"bookable_rate":        o.GetBookableRate(),
+				"total_rate":           o.GetTotalRate(),
+				"total_rate_inclusive": o.GetTotalRateInclusive(),
+				"average_nightly_rate": o.GetAverageNightlyRate(),
+			}
+		}
+		out = append(out, plan)
+	}
+	return out
+}
+
+// chargesJSON itemizes the taxes and fees between total_rate and
+// total_rate_inclusive.
+func chargesJSON(charges []*rate.Charge) []interface{} {
+	out := make([]interface{}, 0, len(charges))
+	for _, c := range charges {
+		out = append(out, map[string]interface{}{
+			"code":        c.GetCode(),
+			"description": c.GetDescription(),
+			"type":        c.GetType(),
+			"amount":      c.GetAmount(),
+		})
+	}
+	return out
+}
+
+// exclusionsJSON lists why rate plans could not be booked for the stay.
+func exclusionsJSON(exclusions []*rate.Exclusion) []interface{} {
+	out := make([]interface{}, 0, len(exclusions))
+	for _, e := range exclusions {
+		out = append(out, map[string]interface{}{
+			"hotel_id":  e.GetHotelId(),
+			"rate_code": e.GetCode(),
+			"room_code": e.GetRoomCode(),
+			"reason":    e.GetReason(),
+			"message":   e.GetMessage(),
+		})
+	}
+	return out
+}
+
 // return a geoJSON response that allows google map to plot points directly on map
 // https://developers.google.com/maps/documentation/javascript/datalayer#sample_geojson
-func geoJSONResponse(hs []*profile.Hotel, ratings map[string]float64) map[string]interface{} {
+//
+// Features follow the search order. Hotels missing from hs (the profile
+// service was degraded) are reduced to their id, rating, rate plans and the
+// location reported by search. A non-nil props keeps only the properties
+// it names.
+func geoJSONResponse(results []*search.Hotel, hs []*profile.Hotel, ratings map[string]float64, props map[string]bool) map[string]interface{} {
 	fs := []interface{}{}
 
+	profiles := make(map[string]*profile.Hotel, len(hs))
 	for _, h := range hs {
-		if h == nil || h.Address == nil {
+		if h != nil {
+			profiles[h.Id] = h
+		}
+	}
+
+	for _, res := range results {
+		h, ok := profiles[res.Id]
+		if !ok {
+			fs = append(fs, partialFeature(res, ratings, props))
+			continue
+		}
+		if h.Address == nil {
 			continue
 		}
+		lat, lon := addressLocation(h.Address)
 
 		fs = append(fs, map[string]interface{}{
 			"type": "Feature",
 			"id":   h.Id,
-			"properties": map[string]interface{}{
+			"properties": selectProperties(map[string]interface{}{
 				"name":         h.Name,
 				"phone_number": h.PhoneNumber,
 				"description":  h.Description,
@@ -239,13 +859,16 @@ func geoJSONResponse(hs []*profile.Hotel, ratings map[string]float64) map[string
 				"postal_code":  h.Address.GetPostalCode(),
 				"rating":       ratings[h.Id],
 				"logo_url":     logoURL(h.Images),
-			},
+				"locale":       h.Locale,
+				"amenities":    amenitiesJSON(h.Amenities),
+				"policies":     policiesJSON(h.Policies),
+				"rate_plans":   ratePlansJSON(res.RatePlans),
+				"score":        res.Score,
+				"distance_km":  res.DistanceKm,
+			}, props),
 			"geometry": map[string]interface{}{
-				"type": "Point",
-				"coordinates": []float32{
-					h.Address.Lon,
-					h.Address.Lat,
-				},
+				"type":        "Point",
+				"coordinates": []float64{lon, lat},
 			},
 		})
 	}
@@ -255,3 +878,106 @@ func geoJSONResponse(hs []*profile.Hotel, ratings map[string]float64) map[string
 		"features": fs,
 	}
 }
+
+// addressLocation returns the location of addr, preferring the double
+// precision fields over the deprecated float ones.
+func addressLocation(addr *profile.Address) (float64, float64) {
+	if addr == nil {
+		return 0, 0
+	}
+	lat, lon := float64(addr.Lat), float64(addr.Lon)
+	if addr.Latitude != nil {
+		lat = *addr.Latitude
+	}
+	if addr.Longitude != nil {
+		lon = *addr.Longitude
+	}
+	return lat, lon
+}
+
+// partialFeature builds a feature for a hotel whose profile is unavailable.
+// City searches carry no location, so their geometry is null.
+func partialFeature(res *search.Hotel, ratings map[string]float64, props map[string]bool) map[string]interface{} {
+	var geometry interface{}
+	if res.Lat != 0 || res.Lon != 0 {
+		geometry = map[string]interface{}{
+			"type":        "Point",
+			"coordinates": []float64{res.Lon, res.Lat},
+		}
+	}
+
+	return map[string]interface{}{
+		"type": "Feature",
+		"id":   res.Id,
+		"properties": selectProperties(map[string]interface{}{
+			"rating":      ratings[res.Id],
+			"rate_plans":  ratePlansJSON(res.RatePlans),
+			"score":       res.Score,
+			"distance_km": res.DistanceKm,
+		}, props),
+		"geometry": geometry,
+	}
+}
+
+func amenitiesJSON(amenities []string) []string {
+	if amenities == nil {
+		return []string{}
+	}
+	return amenities
+}
+
+// policiesJSON returns the policies of a hotel, or nil for hotels that do
+// not publish them.
+func policiesJSON(p *profile.Policies) interface{} {
+	if p == nil {
+		return nil
+	}
+	out := map[string]interface{}{
+		"check_in":   p.CheckIn,
+		"check_out":  p.CheckOut,
+		"refundable": p.FreeCancellationHours != nil,
+	}
+	if p.FreeCancellationHours != nil {
+		out["free_cancellation_hours"] = p.GetFreeCancellationHours()
+	}
+	return out
+}
+
+// hotelResponse returns the full profile of a single hotel along with its
+// rating and every rate plan for the requested stay.
+func hotelResponse(h *profile.Hotel, ratings map[string]float64, ratePlans []*rate.RatePlan) map[string]interface{} {
+	images := make([]interface{}, 0, len(h.Images))
+	for _, img := range h.Images {
+		images = append(images, map[string]interface{}{
+			"url":     img.Url,
+			"default": img.Default,
+		})
+	}
+
+	addr := h.GetAddress()
+	lat, lon := addressLocation(addr)
+	return map[string]interface{}{
+		"id":           h.Id,
+		"name":         h.Name,
+		"phone_number": h.PhoneNumber,
+		"description":  h.Description,
+		"address": map[string]interface{}{
+			"street_number": addr.GetStreetNumber(),
+			"street_name":   addr.GetStreetName(),
+			"city":          addr.GetCity(),
+			"state":         addr.GetState(),
+			"country":       addr.GetCountry(),
+			"postal_code":   addr.GetPostalCode(),
+			"lat":           lat,
+			"lon":           lon,
+		},
+		"address_line": formatAddress(addr),
+		"rating":       ratings[h.Id],
+		"logo_url":     logoURL(h.Images),
+		"locale":       h.Locale,
+		"images":       images,
+		"amenities":    amenitiesJSON(h.Amenities),
+		"policies":     policiesJSON(h.Policies),
+		"rate_plans":   ratePlansJSON(ratePlans),
+	}
+}
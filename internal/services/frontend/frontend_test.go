@@ -5,28 +5,68 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/harlow/go-micro-services/internal/rpcerr"
 	profile "github.com/harlow/go-micro-services/internal/services/profile/proto"
+	rate "github.com/harlow/go-micro-services/internal/services/rate/proto"
+	reservation "github.com/harlow/go-micro-services/internal/services/reservation/proto"
 	search "github.com/harlow/go-micro-services/internal/services/search/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type fakeSearchClient struct {
-	resp *search.SearchResult
-	err  error
+	req       *search.NearbyRequest
+	cityReq   *search.CityRequest
+	withinReq *search.WithinRequest
+	resp      *search.SearchResult
+	err       error
 }
 
 func (f *fakeSearchClient) Nearby(ctx context.Context, in *search.NearbyRequest, opts ...grpc.CallOption) (*search.SearchResult, error) {
+	f.req = in
+	return f.resp, f.err
+}
+
+func (f *fakeSearchClient) City(ctx context.Context, in *search.CityRequest, opts ...grpc.CallOption) (*search.SearchResult, error) {
+	f.cityReq = in
+	return f.resp, f.err
+}
+
+func (f *fakeSearchClient) Within(ctx context.Context, in *search.WithinRequest, opts ...grpc.CallOption) (*search.SearchResult, error) {
+	f.withinReq = in
 	return f.resp, f.err
 }
 
 type fakeProfileClient struct {
+	req  *profile.Request
 	resp *profile.Result
 	err  error
 }
 
 func (f *fakeProfileClient) GetProfiles(ctx context.Context, in *profile.Request, opts ...grpc.CallOption) (*profile.Result, error) {
+	f.req = in
+	return f.resp, f.err
+}
+
+func (f *fakeProfileClient) FindByCity(ctx context.Context, in *profile.CityRequest, opts ...grpc.CallOption) (*profile.CityResult, error) {
+	return nil, f.err
+}
+
+type fakeRateClient struct {
+	req  *rate.Request
+	resp *rate.Result
+	err  error
+}
+
+func (f *fakeRateClient) GetRates(ctx context.Context, in *rate.Request, opts ...grpc.CallOption) (*rate.Result, error) {
+	f.req = in
 	return f.resp, f.err
 }
 
@@ -54,11 +94,342 @@ func TestSearchHandler_ValidatesDateRange(t *testing.T) {
 	}
 }
 
+func TestSearchHandler_ValidatesLocation(t *testing.T) {
+	tests := []string{
+		"lat=37.7",
+		"lat=abc&lon=-122.4",
+		"lat=91&lon=-122.4",
+		"lat=37.7&lon=-181",
+		"lat=37.7&lon=-122.4&radius=0",
+		"lat=37.7&lon=-122.4&radius=NaN",
+		"nearest=0",
+		"nearest=5&radius=10",
+		"city=Portland&nearest=5",
+		"bbox=-122.5,37.7,-122.3",
+		"bbox=-122.5,37.8,-122.3,37.7",
+		"bbox=-122.5,37.7,-122.3,37.8&lat=37.7&lon=-122.4",
+		"bbox=-122.5,37.7,-122.3,37.8&city=Portland",
+		"city=Portland&lat=45.5&lon=-122.6",
+		"city=Portland&sort=distance",
+		"sort=stars",
+		"sort=price&order=up",
+		"limit=0",
+		"limit=ten",
+	}
+
+	for _, query := range tests {
+		svc := &Frontend{
+			searchClient:  &fakeSearchClient{},
+			profileClient: &fakeProfileClient{},
+			ratings:       map[string]float64{},
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10&"+query, nil)
+		rr := httptest.NewRecorder()
+		svc.searchHandler(rr, req)
+
+		if rr.Code != http.StatusBadRequest {
+			t.Fatalf("%s: status = %d, want %d", query, rr.Code, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestSearchHandler_PassesLocationToSearch(t *testing.T) {
+	searchClient := &fakeSearchClient{resp: &search.SearchResult{}}
+	svc := &Frontend{
+		searchClient:  searchClient,
+		profileClient: &fakeProfileClient{resp: &profile.Result{}},
+		ratings:       map[string]float64{},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10&lat=40.7128&lon=-74.006&radius=5", nil)
+	rr := httptest.NewRecorder()
+	svc.searchHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	got := searchClient.req
+	if got.GetLatitude() != 40.7128 || got.GetLongitude() != -74.006 || got.RadiusKm != 5 {
+		t.Fatalf("search request = (%v, %v, %v), want (40.7128, -74.006, 5)", got.GetLatitude(), got.GetLongitude(), got.RadiusKm)
+	}
+	// older search servers still read the float fields
+	if got.Lat != 40.7128 || got.Lon != -74.006 {
+		t.Fatalf("legacy search location = (%v, %v), want (40.7128, -74.006)", got.Lat, got.Lon)
+	}
+}
+
+func TestSearchHandler_PassesSortAndPage(t *testing.T) {
+	searchClient := &fakeSearchClient{resp: &search.SearchResult{NextCursor: "next"}}
+	svc := &Frontend{
+		searchClient:  searchClient,
+		profileClient: &fakeProfileClient{resp: &profile.Result{}},
+		ratings:       map[string]float64{},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10&sort=price&order=desc&limit=5&cursor=abc", nil)
+	rr := httptest.NewRecorder()
+	svc.searchHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	got := searchClient.req
+	if got.Sort != "price" || got.Order != "desc" || got.Limit != 5 || got.Cursor != "abc" {
+		t.Fatalf("unexpected search request: %v", got)
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("json unmarshal: %v", err)
+	}
+	if body["next_cursor"] != "next" {
+		t.Fatalf("next_cursor = %v, want next", body["next_cursor"])
+	}
+}
+
+func TestSearchHandler_PassesWeightsAndRanking(t *testing.T) {
+	searchClient := &fakeSearchClient{resp: &search.SearchResult{Ranker: "weighted"}}
+	svc := &Frontend{
+		searchClient:  searchClient,
+		profileClient: &fakeProfileClient{resp: &profile.Result{}},
+		ratings:       map[string]float64{},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10&sort=weighted&weights=distance:2,rating:1", nil)
+	rr := httptest.NewRecorder()
+	svc.searchHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	w := searchClient.req.Weights
+	if searchClient.req.Sort != "weighted" || w.Distance != 2 || w.Price != 0 || w.Rating != 1 {
+		t.Fatalf("unexpected search request: %v", searchClient.req)
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("json unmarshal: %v", err)
+	}
+	if body["ranking"] != "weighted" {
+		t.Fatalf("ranking = %v, want weighted", body["ranking"])
+	}
+
+	for _, q := range []string{"sort=price&weights=price:1", "sort=weighted&weights=stars:1", "sort=weighted&weights=price:-1"} {
+		rr := httptest.NewRecorder()
+		svc.searchHandler(rr, httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10&"+q, nil))
+		if rr.Code != http.StatusBadRequest {
+			t.Fatalf("%s: status = %d, want %d", q, rr.Code, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestSearchHandler_PassesRatePlanFilter(t *testing.T) {
+	searchClient := &fakeSearchClient{resp: &search.SearchResult{}}
+	svc := &Frontend{
+		searchClient:  searchClient,
+		profileClient: &fakeProfileClient{resp: &profile.Result{}},
+		ratings:       map[string]float64{},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10&rate_code=RACK,AAA&room_code=QN&all_rate_plans=true", nil)
+	rr := httptest.NewRecorder()
+	svc.searchHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	f := searchClient.req.RatePlans
+	if len(f.RateCodes) != 2 || f.RateCodes[1] != "AAA" || len(f.RoomCodes) != 1 || !f.All {
+		t.Fatalf("unexpected rate plan filter: %v", f)
+	}
+
+	rr = httptest.NewRecorder()
+	svc.searchHandler(rr, httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10&all_rate_plans=maybe", nil))
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusBadRequest)
+	}
+}
+
+func TestSearchHandler_PassesAmenities(t *testing.T) {
+	searchClient := &fakeSearchClient{resp: &search.SearchResult{}}
+	svc := &Frontend{
+		searchClient:  searchClient,
+		profileClient: &fakeProfileClient{resp: &profile.Result{}},
+		ratings:       map[string]float64{},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10&amenities=WiFi,,pool", nil)
+	rr := httptest.NewRecorder()
+	svc.searchHandler(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	if got := searchClient.req.Amenities; !reflect.DeepEqual(got, []string{"wifi", "pool"}) {
+		t.Fatalf("amenities = %v, want [wifi pool]", got)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10&city=San+Francisco&amenities=parking", nil)
+	rr = httptest.NewRecorder()
+	svc.searchHandler(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	if got := searchClient.cityReq.Amenities; !reflect.DeepEqual(got, []string{"parking"}) {
+		t.Fatalf("city amenities = %v, want [parking]", got)
+	}
+
+	// search rejects amenities it does not know
+	searchClient.err = rpcerr.InvalidArgument("amenities", `unknown amenity "wify"`)
+	req = httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10&amenities=wify", nil)
+	rr = httptest.NewRecorder()
+	svc.searchHandler(rr, req)
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("unknown amenity status = %d, want %d", rr.Code, http.StatusBadRequest)
+	}
+}
+
+func TestSearchHandler_ReportsExcludedHotels(t *testing.T) {
+	svc := &Frontend{
+		searchClient: &fakeSearchClient{resp: &search.SearchResult{Exclusions: []*rate.Exclusion{
+			{HotelId: "7", Code: "RACK", RoomCode: "KNG", Reason: "MIN_NIGHTS", Message: "minimum stay 2 nights"},
+		}}},
+		profileClient: &fakeProfileClient{resp: &profile.Result{}},
+		ratings:       map[string]float64{},
+	}
+
+	rr := httptest.NewRecorder()
+	svc.searchHandler(rr, httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-10&outDate=2015-04-11", nil))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+
+	var body struct {
+		Excluded []map[string]string `json:"excluded"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("json unmarshal: %v", err)
+	}
+	if len(body.Excluded) != 1 || body.Excluded[0]["hotel_id"] != "7" || body.Excluded[0]["message"] != "minimum stay 2 nights" {
+		t.Fatalf("unexpected excluded: %v", body.Excluded)
+	}
+}
+
+func TestSearchHandler_MapsUpstreamErrors(t *testing.T) {
+	tests := []struct {
+		err        error
+		status     int
+		code       string
+		retryAfter string
+	}{
+		{rpcerr.InvalidArgument("inDate", "expected YYYY-MM-DD"), http.StatusBadRequest, "INVALID_ARGUMENT", ""},
+		{rpcerr.NotFound("city", "Atlantis", "no hotels found"), http.StatusNotFound, "NOT_FOUND", ""},
+		{rpcerr.Unavailable("draining", 5*time.Second), http.StatusServiceUnavailable, "UNAVAILABLE", "5"},
+		{status.Error(codes.DeadlineExceeded, "too slow"), http.StatusGatewayTimeout, "DEADLINE_EXCEEDED", "1"},
+		{status.Error(codes.Internal, "boom"), http.StatusBadGateway, "UPSTREAM_ERROR", ""},
+	}
+
+	for _, tt := range tests {
+		svc := &Frontend{
+			searchClient:  &fakeSearchClient{err: tt.err},
+			profileClient: &fakeProfileClient{},
+			ratings:       map[string]float64{},
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10", nil)
+		rr := httptest.NewRecorder()
+		svc.searchHandler(rr, req)
+
+		if rr.Code != tt.status {
+			t.Fatalf("%v: status = %d, want %d", tt.err, rr.Code, tt.status)
+		}
+		if got := rr.Header().Get("Retry-After"); got != tt.retryAfter {
+			t.Fatalf("%v: Retry-After = %q, want %q", tt.err, got, tt.retryAfter)
+		}
+
+		var body map[string]map[string]interface{}
+		if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+			t.Fatalf("json unmarshal: %v", err)
+		}
+		if body["error"]["code"] != tt.code {
+			t.Fatalf("%v: error.code = %v, want %s", tt.err, body["error"]["code"], tt.code)
+		}
+		if body["error"]["retryable"] != (tt.retryAfter != "") {
+			t.Fatalf("%v: error.retryable = %v", tt.err, body["error"]["retryable"])
+		}
+	}
+}
+
+func TestSearchHandler_SearchesByCity(t *testing.T) {
+	searchClient := &fakeSearchClient{resp: &search.SearchResult{}}
+	svc := &Frontend{
+		searchClient:  searchClient,
+		profileClient: &fakeProfileClient{resp: &profile.Result{}},
+		ratings:       map[string]float64{},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10&city=San+Francisco", nil)
+	rr := httptest.NewRecorder()
+	svc.searchHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	if searchClient.req != nil {
+		t.Fatalf("unexpected nearby search: %v", searchClient.req)
+	}
+	if searchClient.cityReq == nil || searchClient.cityReq.City != "San Francisco" {
+		t.Fatalf("city request = %v, want city San Francisco", searchClient.cityReq)
+	}
+}
+
+func TestSearchHandler_SearchesViewport(t *testing.T) {
+	searchClient := &fakeSearchClient{resp: &search.SearchResult{}}
+	svc := &Frontend{
+		searchClient:  searchClient,
+		profileClient: &fakeProfileClient{resp: &profile.Result{}},
+		ratings:       map[string]float64{},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10&bbox=179.5,-17.9,-179.5,-16.1", nil)
+	rr := httptest.NewRecorder()
+	svc.searchHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	got := searchClient.withinReq
+	if got == nil || searchClient.req != nil {
+		t.Fatalf("expected a viewport search, got nearby %v", searchClient.req)
+	}
+	if got.NorthEast.Lat != -16.1 || got.NorthEast.Lon != -179.5 || got.SouthWest.Lat != -17.9 || got.SouthWest.Lon != 179.5 {
+		t.Fatalf("unexpected viewport: %v", got)
+	}
+}
+
 func TestSearchHandler_ReturnsGeoJSON(t *testing.T) {
 	svc := &Frontend{
 		searchClient: &fakeSearchClient{
 			resp: &search.SearchResult{
 				HotelIds: []string{"hotel-1"},
+				Hotels: []*search.Hotel{
+					{
+						Id: "hotel-1",
+						RatePlans: []*rate.RatePlan{
+							{
+								HotelId: "hotel-1",
+								Code:    "RACK",
+								RoomType: &rate.RoomType{
+									Code:               "KNG",
+									BookableRate:       109,
+									TotalRate:          109,
+									TotalRateInclusive: 123.17,
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 		profileClient: &fakeProfileClient{
@@ -75,7 +446,11 @@ func TestSearchHandler_ReturnsGeoJSON(t *testing.T) {
 							PostalCode:   "94105",
 							Lat:          37.79,
 							Lon:          -122.40,
+							Latitude:     proto.Float64(37.7912345),
+							Longitude:    proto.Float64(-122.4012345),
 						},
+						Amenities: []string{"wifi", "pool"},
+						Policies:  &profile.Policies{CheckIn: "15:00", CheckOut: "12:00", FreeCancellationHours: proto.Int32(48)},
 					},
 				},
 			},
@@ -101,6 +476,308 @@ func TestSearchHandler_ReturnsGeoJSON(t *testing.T) {
 	if body["type"] != "FeatureCollection" {
 		t.Fatalf("type = %v, want FeatureCollection", body["type"])
 	}
+	if body["truncated"] != false {
+		t.Fatalf("truncated = %v, want false", body["truncated"])
+	}
+
+	features := body["features"].([]interface{})
+	props := features[0].(map[string]interface{})["properties"].(map[string]interface{})
+	plans := props["rate_plans"].([]interface{})
+	if len(plans) != 1 {
+		t.Fatalf("rate_plans length = %d, want 1", len(plans))
+	}
+	plan := plans[0].(map[string]interface{})
+	if plan["room_code"] != "KNG" || plan["total_rate_inclusive"] != 123.17 {
+		t.Fatalf("unexpected rate plan: %v", plan)
+	}
+	if amenities := props["amenities"].([]interface{}); len(amenities) != 2 || amenities[1] != "pool" {
+		t.Fatalf("amenities = %v, want [wifi pool]", props["amenities"])
+	}
+	policies := props["policies"].(map[string]interface{})
+	if policies["check_in"] != "15:00" || policies["refundable"] != true || policies["free_cancellation_hours"] != 48.0 {
+		t.Fatalf("unexpected policies: %v", policies)
+	}
+
+	coords := features[0].(map[string]interface{})["geometry"].(map[string]interface{})["coordinates"].([]interface{})
+	if coords[0] != -122.4012345 || coords[1] != 37.7912345 {
+		t.Fatalf("coordinates = %v, want [-122.4012345 37.7912345]", coords)
+	}
+}
+
+func TestSearchHandler_SelectsFields(t *testing.T) {
+	profiles := &fakeProfileClient{
+		resp: &profile.Result{
+			Hotels: []*profile.Hotel{
+				{
+					Id:   "hotel-1",
+					Name: "Hotel One",
+					Address: &profile.Address{
+						Latitude:  proto.Float64(37.79),
+						Longitude: proto.Float64(-122.40),
+					},
+				},
+			},
+		},
+	}
+	svc := &Frontend{
+		searchClient: &fakeSearchClient{
+			resp: &search.SearchResult{
+				HotelIds: []string{"hotel-1"},
+				Hotels:   []*search.Hotel{{Id: "hotel-1"}},
+			},
+		},
+		profileClient: profiles,
+		ratings:       map[string]float64{"hotel-1": 4.4},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10", nil)
+	rr := httptest.NewRecorder()
+	svc.searchHandler(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	if mask := profiles.req.FieldMask; !mask.IsValid(&profile.Hotel{}) || len(mask.Paths) != 17 {
+		t.Fatalf("expected a mask of the fields features use, got %v", mask)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10&fields=name,rating", nil)
+	rr = httptest.NewRecorder()
+	svc.searchHandler(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	want := []string{"address.lat", "address.latitude", "address.lon", "address.longitude", "name"}
+	if got := profiles.req.FieldMask.GetPaths(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("mask paths = %v, want %v", got, want)
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("json unmarshal: %v", err)
+	}
+	feature := body["features"].([]interface{})[0].(map[string]interface{})
+	props := feature["properties"].(map[string]interface{})
+	if len(props) != 2 || props["name"] != "Hotel One" || props["rating"] != 4.4 {
+		t.Fatalf("unexpected properties: %v", props)
+	}
+	if feature["geometry"] == nil {
+		t.Fatalf("expected geometry to be kept, got %v", feature)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10&fields=name,stars", nil)
+	rr = httptest.NewRecorder()
+	svc.searchHandler(rr, req)
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusBadRequest)
+	}
+}
+
+func TestSearchHandler_PartialWhenProfileTimesOut(t *testing.T) {
+	svc := &Frontend{
+		searchClient: &fakeSearchClient{
+			resp: &search.SearchResult{
+				HotelIds: []string{"hotel-1"},
+				Hotels: []*search.Hotel{
+					{
+						Id:        "hotel-1",
+						Lat:       37.79,
+						Lon:       -122.40,
+						RatePlans: []*rate.RatePlan{{HotelId: "hotel-1", Code: "RACK"}},
+					},
+				},
+			},
+		},
+		profileClient: &fakeProfileClient{err: status.Error(codes.DeadlineExceeded, "slow")},
+		ratings:       map[string]float64{"hotel-1": 4.4},
+		timeouts:      Timeouts{Profile: time.Millisecond},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/hotels?inDate=2015-04-09&outDate=2015-04-10", nil)
+	rr := httptest.NewRecorder()
+	svc.searchHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("json unmarshal: %v", err)
+	}
+	if body["partial"] != true {
+		t.Fatalf("partial = %v, want true", body["partial"])
+	}
+	feature := body["features"].([]interface{})[0].(map[string]interface{})
+	coords := feature["geometry"].(map[string]interface{})["coordinates"].([]interface{})
+	if feature["id"] != "hotel-1" || coords[0] != -122.40 || coords[1] != 37.79 {
+		t.Fatalf("unexpected partial feature: %v", feature)
+	}
+	props := feature["properties"].(map[string]interface{})
+	if len(props["rate_plans"].([]interface{})) != 1 {
+		t.Fatalf("unexpected partial properties: %v", props)
+	}
+}
+
+func TestHotelHandler_ReturnsDetail(t *testing.T) {
+	rateClient := &fakeRateClient{
+		resp: &rate.Result{RatePlans: []*rate.RatePlan{
+			{HotelId: "hotel-1", Code: "RACK", RoomType: &rate.RoomType{
+				Code:               "KNG",
+				TotalRate:          109,
+				TotalRateInclusive: 126.21,
+				RoomsLeft:          3,
+				Charges: []*rate.Charge{
+					{Code: "TOT", Description: "Transient occupancy tax", Type: "tax", Amount: 15.26},
+					{Code: "TID", Description: "Tourism improvement district assessment", Type: "tax", Amount: 1.64},
+				},
+			}},
+			{HotelId: "hotel-1", Code: "AAA", RoomType: &rate.RoomType{Code: "KNG"}},
+		}},
+	}
+	svc := &Frontend{
+		searchClient: &fakeSearchClient{},
+		profileClient: &fakeProfileClient{
+			resp: &profile.Result{
+				Hotels: []*profile.Hotel{
+					{
+						Id:      "hotel-1",
+						Name:    "Hotel One",
+						Address: &profile.Address{StreetNumber: "1", StreetName: "Market St", City: "San Francisco"},
+						Images: []*profile.Image{
+							{Url: "/logos/one.svg", Default: true},
+							{Url: "/photos/lobby.jpg"},
+						},
+					},
+				},
+			},
+		},
+		rateClient: rateClient,
+		ratings:    map[string]float64{"hotel-1": 4.4},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/hotels/hotel-1?inDate=2015-04-09&outDate=2015-04-10", nil)
+	req.SetPathValue("id", "hotel-1")
+	rr := httptest.NewRecorder()
+	svc.hotelHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	if len(rateClient.req.HotelIds) != 1 || rateClient.req.HotelIds[0] != "hotel-1" {
+		t.Fatalf("rate request hotel ids = %v, want [hotel-1]", rateClient.req.HotelIds)
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("json unmarshal: %v", err)
+	}
+	if body["address_line"] != "1 Market St, San Francisco" || body["rating"] != 4.4 {
+		t.Fatalf("unexpected detail: %v", body)
+	}
+	if n := len(body["images"].([]interface{})); n != 2 {
+		t.Fatalf("images length = %d, want 2", n)
+	}
+	plans := body["rate_plans"].([]interface{})
+	if len(plans) != 2 {
+		t.Fatalf("rate_plans length = %d, want 2", len(plans))
+	}
+	if left := plans[0].(map[string]interface{})["rooms_left"]; left != 3.0 {
+		t.Fatalf("rooms_left = %v, want 3", left)
+	}
+	if _, ok := plans[1].(map[string]interface{})["rooms_left"]; ok {
+		t.Fatalf("rooms_left set without availability: %v", plans[1])
+	}
+	charges := plans[0].(map[string]interface{})["charges"].([]interface{})
+	if len(charges) != 2 {
+		t.Fatalf("charges length = %d, want 2", len(charges))
+	}
+	if c := charges[0].(map[string]interface{}); c["code"] != "TOT" || c["type"] != "tax" || c["amount"] != 15.26 {
+		t.Fatalf("unexpected charge: %v", c)
+	}
+}
+
+func TestHotelHandler_ConvertsCurrency(t *testing.T) {
+	rateClient := &fakeRateClient{
+		resp: &rate.Result{RatePlans: []*rate.RatePlan{{
+			HotelId: "hotel-1",
+			Code:    "RACK",
+			RoomType: &rate.RoomType{
+				Currency:     "EUR",
+				TotalRate:    100.44,
+				ExchangeRate: 0.9215,
+				Original:     &rate.Price{Currency: "USD", TotalRate: 109},
+			},
+		}}},
+	}
+	svc := &Frontend{
+		profileClient: &fakeProfileClient{resp: &profile.Result{Hotels: []*profile.Hotel{{Id: "hotel-1"}}}},
+		rateClient:    rateClient,
+		ratings:       map[string]float64{},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/hotels/hotel-1?inDate=2015-04-09&outDate=2015-04-10&currency=eur", nil)
+	req.SetPathValue("id", "hotel-1")
+	rr := httptest.NewRecorder()
+	svc.hotelHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	if rateClient.req.Currency != "EUR" {
+		t.Fatalf("rate request currency = %q, want EUR", rateClient.req.Currency)
+	}
+
+	var body struct {
+		RatePlans []struct {
+			Currency     string  `json:"currency"`
+			ExchangeRate float64 `json:"exchange_rate"`
+			Original     struct {
+				Currency  string  `json:"currency"`
+				TotalRate float64 `json:"total_rate"`
+			} `json:"original"`
+		} `json:"rate_plans"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("json unmarshal: %v", err)
+	}
+	p := body.RatePlans[0]
+	if p.Currency != "EUR" || p.ExchangeRate != 0.9215 || p.Original.Currency != "USD" || p.Original.TotalRate != 109 {
+		t.Fatalf("unexpected rate plan: %+v", p)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/hotels/hotel-1?inDate=2015-04-09&outDate=2015-04-10&currency=euro", nil)
+	req.SetPathValue("id", "hotel-1")
+	rr = httptest.NewRecorder()
+	svc.hotelHandler(rr, req)
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHotelHandler_UnknownHotel(t *testing.T) {
+	svc := &Frontend{
+		searchClient:  &fakeSearchClient{},
+		profileClient: &fakeProfileClient{resp: &profile.Result{}},
+		rateClient:    &fakeRateClient{},
+		ratings:       map[string]float64{},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/hotels/nope?inDate=2015-04-09&outDate=2015-04-10", nil)
+	req.SetPathValue("id", "nope")
+	rr := httptest.NewRecorder()
+	svc.hotelHandler(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusNotFound)
+	}
+
+	var body map[string]map[string]string
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("json unmarshal: %v", err)
+	}
+	if body["error"]["code"] != "NOT_FOUND" {
+		t.Fatalf("error.code = %q, want NOT_FOUND", body["error"]["code"])
+	}
 }
 
 func TestReadyHandler_DownstreamFailure(t *testing.T) {
@@ -118,3 +795,369 @@ func TestReadyHandler_DownstreamFailure(t *testing.T) {
 		t.Fatalf("status = %d, want %d", rr.Code, http.StatusServiceUnavailable)
 	}
 }
+
+type fakeReservationClient struct {
+	reservation.ReservationClient
+	holds   []*reservation.HoldRequest
+	cancels []string
+	holdErr error
+	// holdPanic makes Hold panic, as a bug in the handler would
+	holdPanic bool
+	getResp   *reservation.Booking
+	getErr    error
+	confirms  int
+}
+
+func (f *fakeReservationClient) Hold(ctx context.Context, in *reservation.HoldRequest, opts ...grpc.CallOption) (*reservation.Booking, error) {
+	f.holds = append(f.holds, in)
+	if f.holdPanic {
+		panic("hold failed")
+	}
+	if f.holdErr != nil {
+		return nil, f.holdErr
+	}
+	return &reservation.Booking{Id: "b1", HotelId: in.HotelId, RoomCode: in.RoomCode, InDate: in.InDate, OutDate: in.OutDate, Rooms: 1, Status: "HELD"}, nil
+}
+
+func (f *fakeReservationClient) Confirm(ctx context.Context, in *reservation.BookingRequest, opts ...grpc.CallOption) (*reservation.Booking, error) {
+	f.confirms++
+	return &reservation.Booking{Id: in.Id, HotelId: "1", RoomCode: "KNG", InDate: "2015-04-09", OutDate: "2015-04-10", Rooms: 1, Status: "CONFIRMED", CreatedAt: "2015-04-01T12:00:00Z"}, nil
+}
+
+func (f *fakeReservationClient) Cancel(ctx context.Context, in *reservation.BookingRequest, opts ...grpc.CallOption) (*reservation.Booking, error) {
+	f.cancels = append(f.cancels, in.Id)
+	return &reservation.Booking{Id: in.Id, Status: "CANCELLED"}, nil
+}
+
+func (f *fakeReservationClient) Get(ctx context.Context, in *reservation.BookingRequest, opts ...grpc.CallOption) (*reservation.Booking, error) {
+	return f.getResp, f.getErr
+}
+
+func postReservation(svc *Frontend, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/reservations", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set("Idempotency-Key", key)
+	}
+	rr := httptest.NewRecorder()
+	svc.createReservationHandler(rr, req)
+	return rr
+}
+
+func TestCreateReservationHandler_Books(t *testing.T) {
+	client := &fakeReservationClient{}
+	svc := &Frontend{reservationClient: client, idempotency: newIdempotencyStore(time.Hour, 10)}
+
+	rr := postReservation(svc, "", `{"hotel_id":"1","room_code":"kng","in_date":"2015-04-09","out_date":"2015-04-10"}`)
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d: %s", rr.Code, http.StatusCreated, rr.Body)
+	}
+	if loc := rr.Header().Get("Location"); loc != "/reservations/b1" {
+		t.Fatalf("Location = %q, want /reservations/b1", loc)
+	}
+	if len(client.holds) != 1 || client.holds[0].RoomCode != "KNG" || client.confirms != 1 {
+		t.Fatalf("unexpected calls: holds %v, confirms %d", client.holds, client.confirms)
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("json unmarshal: %v", err)
+	}
+	if body["id"] != "b1" || body["status"] != "CONFIRMED" || body["in_date"] != "2015-04-09" {
+		t.Fatalf("unexpected booking: %v", body)
+	}
+}
+
+func TestCreateReservationHandler_Validates(t *testing.T) {
+	tests := []string{
+		`{"hotel_id":"1","room_code":"KNG","in_date":"2015/04/09","out_date":"2015-04-10"}`,
+		`{"hotel_id":"1","room_code":"KNG","in_date":"2015-04-10","out_date":"2015-04-09"}`,
+		`{"hotel_id":"1","room_code":"KNG","in_date":"2015-04-01","out_date":"2015-05-09"}`,
+		`{"hotel_id":"1","in_date":"2015-04-09","out_date":"2015-04-10"}`,
+		`{"hotel_id":"1","room_code":"KNG","in_date":"2015-04-09","out_date":"2015-04-10","nights":1}`,
+		`{"hotel_id":"1","room_code":"KNG","inDate":"2015-04-09","outDate":"2015-04-10"}`,
+		`not json`,
+	}
+
+	for _, body := range tests {
+		client := &fakeReservationClient{}
+		svc := &Frontend{reservationClient: client, idempotency: newIdempotencyStore(time.Hour, 10)}
+		rr := postReservation(svc, "", body)
+		if rr.Code != http.StatusBadRequest {
+			t.Fatalf("%s: status = %d, want %d", body, rr.Code, http.StatusBadRequest)
+		}
+		if len(client.holds) != 0 {
+			t.Fatalf("%s: invalid request reached the reservation service", body)
+		}
+	}
+}
+
+func TestCreateReservationHandler_IdempotencyKey(t *testing.T) {
+	client := &fakeReservationClient{}
+	svc := &Frontend{reservationClient: client, idempotency: newIdempotencyStore(time.Hour, 10)}
+	body := `{"hotel_id":"1","room_code":"KNG","in_date":"2015-04-09","out_date":"2015-04-10"}`
+
+	first := postReservation(svc, "retry-1", body)
+	second := postReservation(svc, "retry-1", body)
+	if first.Code != http.StatusCreated || second.Code != http.StatusCreated {
+		t.Fatalf("status = %d, %d, want %d", first.Code, second.Code, http.StatusCreated)
+	}
+	if len(client.holds) != 1 {
+		t.Fatalf("retry booked again: %d holds", len(client.holds))
+	}
+	if second.Header().Get("Idempotent-Replayed") != "true" || second.Header().Get("Location") != "/reservations/b1" {
+		t.Fatalf("unexpected replay headers: %v", second.Header())
+	}
+	if first.Body.String() != second.Body.String() {
+		t.Fatalf("replayed body differs:\n%s\n%s", first.Body, second.Body)
+	}
+
+	rr := postReservation(svc, "retry-1", `{"hotel_id":"2","room_code":"KNG","in_date":"2015-04-09","out_date":"2015-04-10"}`)
+	if rr.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("reused key status = %d, want %d", rr.Code, http.StatusUnprocessableEntity)
+	}
+
+	// retryable failures are not recorded, so a retry books
+	client.holdErr = status.Error(codes.Unavailable, "down")
+	if rr := postReservation(svc, "retry-2", body); rr.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusServiceUnavailable)
+	}
+	client.holdErr = nil
+	if rr := postReservation(svc, "retry-2", body); rr.Code != http.StatusCreated {
+		t.Fatalf("retry status = %d, want %d", rr.Code, http.StatusCreated)
+	}
+}
+
+func TestCreateReservationHandler_IdempotencyKeyReleasedOnPanic(t *testing.T) {
+	client := &fakeReservationClient{holdPanic: true}
+	svc := &Frontend{reservationClient: client, idempotency: newIdempotencyStore(time.Hour, 10)}
+	body := `{"hotel_id":"1","room_code":"KNG","in_date":"2015-04-09","out_date":"2015-04-10"}`
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatalf("handler did not panic")
+			}
+		}()
+		postReservation(svc, "retry-1", body)
+	}()
+
+	client.holdPanic = false
+	rr := postReservation(svc, "retry-1", body)
+	if rr.Code != http.StatusCreated || rr.Header().Get("Idempotent-Replayed") != "" {
+		t.Fatalf("retry status = %d, headers %v, want a new booking", rr.Code, rr.Header())
+	}
+	if len(client.holds) != 2 {
+		t.Fatalf("holds = %d, want 2", len(client.holds))
+	}
+}
+
+func TestIdempotencyStore_IsBounded(t *testing.T) {
+	now := time.Date(2015, 4, 1, 12, 0, 0, 0, time.UTC)
+	store := newIdempotencyStore(time.Hour, 2)
+	store.now = func() time.Time { return now }
+	fp := [32]byte{1}
+	rec := newResponseRecorder(httptest.NewRecorder())
+	rec.WriteHeader(http.StatusCreated)
+
+	a, _ := store.claim("a", fp)
+	b, _ := store.claim("b", fp)
+	if _, state := store.claim("c", fp); state != keyStoreFull {
+		t.Fatalf("state = %d, want keyStoreFull while every key is in progress", state)
+	}
+
+	// a full store forgets the oldest recorded response to make room
+	store.finish(a, rec)
+	now = now.Add(time.Minute)
+	store.finish(b, rec)
+	if _, state := store.claim("c", fp); state != keyClaimed {
+		t.Fatalf("state = %d, want keyClaimed", state)
+	}
+	if _, ok := store.entries["a"]; ok {
+		t.Fatalf("a is still remembered")
+	}
+	if _, ok := store.entries["b"]; !ok {
+		t.Fatalf("b was forgotten before a")
+	}
+
+	// expired responses are dropped in the order they were recorded
+	now = now.Add(time.Hour)
+	if _, state := store.claim("d", fp); state != keyClaimed {
+		t.Fatalf("state = %d, want keyClaimed", state)
+	}
+	if _, ok := store.entries["b"]; ok || len(store.entries) != 2 {
+		t.Fatalf("entries = %v, want b expired", store.entries)
+	}
+}
+
+func TestCreateReservationHandler_ScopesKeysByClient(t *testing.T) {
+	client := &fakeReservationClient{}
+	svc := &Frontend{reservationClient: client, idempotency: newIdempotencyStore(time.Hour, 10)}
+	body := `{"hotel_id":"1","room_code":"KNG","in_date":"2015-04-09","out_date":"2015-04-10"}`
+
+	for _, auth := range []string{"Bearer alice", "Bearer bob"} {
+		req := httptest.NewRequest(http.MethodPost, "/reservations", strings.NewReader(body))
+		req.Header.Set("Idempotency-Key", "booking-1")
+		req.Header.Set("Authorization", auth)
+		rr := httptest.NewRecorder()
+		svc.createReservationHandler(rr, req)
+		if rr.Code != http.StatusCreated || rr.Header().Get("Idempotent-Replayed") != "" {
+			t.Fatalf("%s: status = %d, headers %v, want a new booking", auth, rr.Code, rr.Header())
+		}
+	}
+	if len(client.holds) != 2 {
+		t.Fatalf("holds = %d, want one per client", len(client.holds))
+	}
+}
+
+func TestReservationsPreflight(t *testing.T) {
+	rr := httptest.NewRecorder()
+	reservationsPreflightHandler(rr, httptest.NewRequest(http.MethodOptions, "/reservations", nil))
+	if rr.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusNoContent)
+	}
+	if got := rr.Header().Get("Access-Control-Allow-Headers"); !strings.Contains(got, "Idempotency-Key") {
+		t.Fatalf("Access-Control-Allow-Headers = %q, want Idempotency-Key allowed", got)
+	}
+	if got := rr.Header().Get("Access-Control-Allow-Methods"); !strings.Contains(got, "DELETE") {
+		t.Fatalf("Access-Control-Allow-Methods = %q, want DELETE allowed", got)
+	}
+}
+
+func TestIdempotencyStore_InProgressAndExpiry(t *testing.T) {
+	now := time.Date(2015, 4, 1, 12, 0, 0, 0, time.UTC)
+	store := newIdempotencyStore(time.Hour, 10)
+	store.now = func() time.Time { return now }
+	fp := [32]byte{1}
+
+	entry, state := store.claim("k", fp)
+	if state != keyClaimed {
+		t.Fatalf("state = %d, want keyClaimed", state)
+	}
+	if _, state := store.claim("k", fp); state != keyInProgress {
+		t.Fatalf("state = %d, want keyInProgress", state)
+	}
+
+	rec := newResponseRecorder(httptest.NewRecorder())
+	rec.WriteHeader(http.StatusCreated)
+	store.finish(entry, rec)
+	if _, state := store.claim("k", fp); state != keyReplay {
+		t.Fatalf("state = %d, want keyReplay", state)
+	}
+
+	now = now.Add(time.Hour)
+	if _, state := store.claim("k", [32]byte{2}); state != keyClaimed {
+		t.Fatalf("state = %d, want an expired key to be claimed again", state)
+	}
+}
+
+func TestCreateReservationHandler_SoldOut(t *testing.T) {
+	client := &fakeReservationClient{holdErr: rpcerr.FailedPrecondition("SOLD_OUT", "1/KNG/2015-04-09", "sold out on 2015-04-09")}
+	svc := &Frontend{reservationClient: client, idempotency: newIdempotencyStore(time.Hour, 10)}
+
+	rr := postReservation(svc, "", `{"hotel_id":"1","room_code":"KNG","in_date":"2015-04-09","out_date":"2015-04-10"}`)
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusConflict)
+	}
+
+	var body struct {
+		Error struct {
+			Code       string              `json:"code"`
+			Violations []map[string]string `json:"precondition_violations"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("json unmarshal: %v", err)
+	}
+	if body.Error.Code != "FAILED_PRECONDITION" || len(body.Error.Violations) != 1 || body.Error.Violations[0]["type"] != "SOLD_OUT" {
+		t.Fatalf("unexpected error: %s", rr.Body)
+	}
+}
+
+func TestReservationHandlers_GetAndCancel(t *testing.T) {
+	client := &fakeReservationClient{getErr: rpcerr.NotFound("reservation", "nope", `reservation "nope" not found`)}
+	svc := &Frontend{reservationClient: client}
+
+	req := httptest.NewRequest(http.MethodGet, "/reservations/nope", nil)
+	req.SetPathValue("id", "nope")
+	rr := httptest.NewRecorder()
+	svc.getReservationHandler(rr, req)
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("get status = %d, want %d", rr.Code, http.StatusNotFound)
+	}
+
+	req = httptest.NewRequest(http.MethodDelete, "/reservations/b1", nil)
+	req.SetPathValue("id", "b1")
+	rr = httptest.NewRecorder()
+	svc.cancelReservationHandler(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("cancel status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	if len(client.cancels) != 1 || client.cancels[0] != "b1" {
+		t.Fatalf("cancelled %v, want [b1]", client.cancels)
+	}
+}
+
+func TestParseLocale(t *testing.T) {
+	for _, tt := range []struct {
+		query, acceptLanguage, want string
+	}{
+		{"", "", "en"},
+		{"locale=fr-CA", "es", "fr-CA"},
+		{"", "fr-CA, fr;q=0.9, en;q=0.8", "fr-CA"},
+		{"", "en;q=0.5, es", "es"},
+		{"", "*", "en"},
+		{"", "not a header;;", "en"},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/hotels?"+tt.query, nil)
+		if tt.acceptLanguage != "" {
+			req.Header.Set("Accept-Language", tt.acceptLanguage)
+		}
+		got, err := parseLocale(req)
+		if err != nil || got != tt.want {
+			t.Fatalf("%q, %q: parseLocale = %q, %v, want %q", tt.query, tt.acceptLanguage, got, err, tt.want)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/hotels?locale=french", nil)
+	if _, err := parseLocale(req); err == nil {
+		t.Fatal("expected an invalid locale to be rejected")
+	}
+}
+
+func TestHotelHandler_ServesLocale(t *testing.T) {
+	profileClient := &fakeProfileClient{resp: &profile.Result{Hotels: []*profile.Hotel{{
+		Id:          "hotel-1",
+		Name:        "Clift Hotel",
+		Description: "Hôtel de luxe",
+		Address:     &profile.Address{City: "San Francisco"},
+		Locale:      "fr",
+	}}}}
+	svc := &Frontend{
+		profileClient: profileClient,
+		rateClient:    &fakeRateClient{resp: &rate.Result{}},
+		ratings:       map[string]float64{},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/hotels/hotel-1?inDate=2015-04-09&outDate=2015-04-10", nil)
+	req.Header.Set("Accept-Language", "fr-CA,fr;q=0.8")
+	req.SetPathValue("id", "hotel-1")
+	rr := httptest.NewRecorder()
+	svc.hotelHandler(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	if profileClient.req.Locale != "fr-CA" {
+		t.Fatalf("profile locale = %q, want fr-CA", profileClient.req.Locale)
+	}
+	if got := rr.Header().Get("Content-Language"); got != "fr" {
+		t.Fatalf("Content-Language = %q, want fr", got)
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatalf("json unmarshal: %v", err)
+	}
+	if body["locale"] != "fr" || body["description"] != "Hôtel de luxe" {
+		t.Fatalf("unexpected detail: %v", body)
+	}
+}
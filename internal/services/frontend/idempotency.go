@@ -0,0 +1,216 @@
+package frontend
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// idempotencyTTL is how long a response is replayed for its key.
+	idempotencyTTL = 24 * time.Hour
+	// maxIdempotencyKey bounds the length of an Idempotency-Key header.
+	maxIdempotencyKey = 255
+	// maxIdempotencyEntries bounds how many keys are remembered at once.
+	maxIdempotencyEntries = 100000
+)
+
+// idempotencyStore remembers the responses to requests sent with an
+// Idempotency-Key header, so a client retrying one gets the original
+// response back instead of repeating its effect. Keys live in memory and
+// are forgotten after the ttl or a restart. At most max keys are kept: when
+// full, the oldest recorded response is forgotten early to make room.
+type idempotencyStore struct {
+	ttl time.Duration
+	max int
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*idempotentResponse
+	// recorded lists the entries with a recorded response in the order
+	// they were recorded, which is also the order they expire in.
+	recorded *list.List
+}
+
+// idempotentResponse is a response recorded for a key. Until done is
+// closed the request is still being handled.
+type idempotentResponse struct {
+	key         string
+	fingerprint [sha256.Size]byte
+	done        chan struct{}
+	expires     time.Time
+	// element of the entry in idempotencyStore.recorded
+	elem *list.Element
+
+	status int
+	header http.Header
+	body   []byte
+}
+
+// Outcomes of claiming an idempotency key.
+const (
+	// the key is new and the caller must handle the request
+	keyClaimed = iota
+	// the request was handled before and its response can be replayed
+	keyReplay
+	// the same request is still being handled
+	keyInProgress
+	// the key was used before for a different request
+	keyMismatch
+	// the store is full of requests still being handled
+	keyStoreFull
+)
+
+func newIdempotencyStore(ttl time.Duration, max int) *idempotencyStore {
+	return &idempotencyStore{
+		ttl:      ttl,
+		max:      max,
+		now:      time.Now,
+		entries:  make(map[string]*idempotentResponse),
+		recorded: list.New(),
+	}
+}
+
+// idempotencyScope returns the key under which a client's Idempotency-Key
+// is stored, so clients picking the same key do not see each other's
+// responses. Clients are told apart by their Authorization header, else by
+// their address.
+func idempotencyScope(r *http.Request, key string) string {
+	client := r.Header.Get("Authorization")
+	if client == "" {
+		client, _, _ = net.SplitHostPort(r.RemoteAddr)
+	}
+	sum := sha256.Sum256([]byte(client))
+	return hex.EncodeToString(sum[:8]) + ":" + key
+}
+
+// claim looks up key for a request with the given fingerprint, claiming it
+// when it is new or expired.
+func (s *idempotencyStore) claim(key string, fingerprint [sha256.Size]byte) (*idempotentResponse, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for el := s.recorded.Front(); el != nil; el = s.recorded.Front() {
+		e := el.Value.(*idempotentResponse)
+		if now.Before(e.expires) {
+			break
+		}
+		s.forget(e)
+	}
+
+	if e, ok := s.entries[key]; ok {
+		switch {
+		case e.fingerprint != fingerprint:
+			return nil, keyMismatch
+		case !isDone(e):
+			return nil, keyInProgress
+		default:
+			return e, keyReplay
+		}
+	}
+
+	if len(s.entries) >= s.max {
+		oldest := s.recorded.Front()
+		if oldest == nil {
+			return nil, keyStoreFull
+		}
+		s.forget(oldest.Value.(*idempotentResponse))
+	}
+
+	e := &idempotentResponse{key: key, fingerprint: fingerprint, done: make(chan struct{})}
+	s.entries[key] = e
+	return e, keyClaimed
+}
+
+// forget drops a recorded entry. s.mu must be held.
+func (s *idempotencyStore) forget(e *idempotentResponse) {
+	s.recorded.Remove(e.elem)
+	delete(s.entries, e.key)
+}
+
+// finish records the response to a claimed key. Server errors are not
+// recorded, so retrying them tries the request again.
+func (s *idempotencyStore) finish(e *idempotentResponse, rec *responseRecorder) {
+	if rec.status >= http.StatusInternalServerError {
+		s.release(e)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e.status = rec.status
+	e.header = rec.header.Clone()
+	e.body = rec.body.Bytes()
+	e.expires = s.now().Add(s.ttl)
+	e.elem = s.recorded.PushBack(e)
+	close(e.done)
+}
+
+// release forgets a claimed key without recording a response, so the next
+// request with it is handled again.
+func (s *idempotencyStore) release(e *idempotentResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, e.key)
+	close(e.done)
+}
+
+func isDone(e *idempotentResponse) bool {
+	select {
+	case <-e.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// replay writes a recorded response to w.
+func (e *idempotentResponse) replay(w http.ResponseWriter) {
+	for k, v := range e.header {
+		w.Header()[k] = v
+	}
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(e.status)
+	w.Write(e.body)
+}
+
+// responseRecorder captures a response while also writing it to the
+// underlying writer.
+type responseRecorder struct {
+	w           http.ResponseWriter
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func newResponseRecorder(w http.ResponseWriter) *responseRecorder {
+	return &responseRecorder{w: w, header: make(http.Header), status: http.StatusOK}
+}
+
+func (r *responseRecorder) Header() http.Header { return r.header }
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.wroteHeader {
+		return
+	}
+	r.status, r.wroteHeader = status, true
+	for k, v := range r.header {
+		r.w.Header()[k] = v
+	}
+	r.w.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if !r.wroteHeader {
+		r.WriteHeader(http.StatusOK)
+	}
+	r.body.Write(b)
+	return r.w.Write(b)
+}
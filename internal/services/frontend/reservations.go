@@ -0,0 +1,195 @@
+package frontend
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/harlow/go-micro-services/internal/dataload"
+	runtime "github.com/harlow/go-micro-services/internal/runtime"
+	reservation "github.com/harlow/go-micro-services/internal/services/reservation/proto"
+)
+
+// maxReservationBody bounds the size of a POST /reservations body.
+const maxReservationBody = 1 << 16
+
+// reservationRequest is the body of POST /reservations. Its keys match
+// the reservations returned by bookingJSON.
+type reservationRequest struct {
+	HotelID  string `json:"hotel_id"`
+	RoomCode string `json:"room_code"`
+	InDate   string `json:"in_date"`
+	OutDate  string `json:"out_date"`
+	Rooms    int32  `json:"rooms"`
+}
+
+// createReservationHandler books rooms for a stay. Requests carrying an
+// Idempotency-Key header are handled once per key: retries with the same
+// body get the recorded response back instead of booking again.
+func (s *Frontend) createReservationHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Expose-Headers", "Location, Idempotent-Replayed, Retry-After")
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxReservationBody))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeJSONError(w, http.StatusRequestEntityTooLarge, "INVALID_ARGUMENT", fmt.Sprintf("request body cannot exceed %d bytes", maxReservationBody))
+			return
+		}
+		writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "failed to read request body")
+		return
+	}
+
+	key := r.Header.Get("Idempotency-Key")
+	if key == "" {
+		s.createReservation(w, r, body)
+		return
+	}
+	if len(key) > maxIdempotencyKey {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", fmt.Sprintf("Idempotency-Key cannot exceed %d characters", maxIdempotencyKey))
+		return
+	}
+
+	entry, state := s.idempotency.claim(idempotencyScope(r, key), sha256.Sum256(body))
+	switch state {
+	case keyStoreFull:
+		w.Header().Set("Retry-After", "1")
+		writeJSONError(w, http.StatusServiceUnavailable, "UNAVAILABLE", "too many requests with an Idempotency-Key are being handled")
+	case keyMismatch:
+		writeJSONError(w, http.StatusUnprocessableEntity, "IDEMPOTENCY_KEY_REUSED", "Idempotency-Key was already used for a different request")
+	case keyInProgress:
+		w.Header().Set("Retry-After", "1")
+		writeJSONError(w, http.StatusConflict, "IDEMPOTENCY_KEY_IN_PROGRESS", "a request with this Idempotency-Key is still being handled")
+	case keyReplay:
+		entry.replay(w)
+	default:
+		rec := newResponseRecorder(w)
+		handled := false
+		defer func() {
+			if !handled {
+				// the handler panicked and its response is unknown
+				s.idempotency.release(entry)
+			}
+		}()
+		s.createReservation(rec, r, body)
+		handled = true
+		s.idempotency.finish(entry, rec)
+	}
+}
+
+// reservationsPreflightHandler answers the CORS preflight browsers send
+// before a cross-origin POST or DELETE, or a request with a JSON body or an
+// Idempotency-Key header.
+func reservationsPreflightHandler(w http.ResponseWriter, r *http.Request) {
+	h := w.Header()
+	h.Set("Access-Control-Allow-Origin", "*")
+	h.Set("Access-Control-Allow-Methods", "GET, POST, DELETE")
+	h.Set("Access-Control-Allow-Headers", "Content-Type, Idempotency-Key")
+	h.Set("Access-Control-Max-Age", "86400")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// createReservation holds the rooms asked for in body and confirms the
+// hold.
+func (s *Frontend) createReservation(w http.ResponseWriter, r *http.Request, body []byte) {
+	ctx := r.Context()
+
+	var req reservationRequest
+	if err := dataload.Strict(body, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "invalid request body: "+strings.TrimPrefix(err.Error(), "json: "))
+		return
+	}
+	if strings.TrimSpace(req.HotelID) == "" || strings.TrimSpace(req.RoomCode) == "" {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "missing required fields: hotel_id and room_code")
+		return
+	}
+	if req.InDate == "" || req.OutDate == "" {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "missing required fields: in_date and out_date")
+		return
+	}
+	if err := validateDateRange("in_date", req.InDate, "out_date", req.OutDate); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "INVALID_ARGUMENT", err.Error())
+		return
+	}
+
+	holdCtx, cancel := runtime.WithBudget(ctx, s.timeouts.Reservation)
+	defer cancel()
+	held, err := s.reservationClient.Hold(holdCtx, &reservation.HoldRequest{
+		HotelId:  req.HotelID,
+		RoomCode: strings.ToUpper(req.RoomCode),
+		InDate:   req.InDate,
+		OutDate:  req.OutDate,
+		Rooms:    req.Rooms,
+	})
+	if err != nil {
+		writeUpstreamError(w, err, "reservation")
+		return
+	}
+
+	confirmCtx, cancel := runtime.WithBudget(ctx, s.timeouts.Reservation)
+	defer cancel()
+	booking, err := s.reservationClient.Confirm(confirmCtx, &reservation.BookingRequest{Id: held.Id})
+	if err != nil {
+		// release the rooms now rather than when the hold expires
+		cancelCtx, cancel := runtime.WithBudget(ctx, s.timeouts.Reservation)
+		defer cancel()
+		if _, cerr := s.reservationClient.Cancel(cancelCtx, &reservation.BookingRequest{Id: held.Id}); cerr != nil {
+			log.Printf("failed to release hold %s: %v", held.Id, cerr)
+		}
+		writeUpstreamError(w, err, "reservation")
+		return
+	}
+
+	w.Header().Set("Location", "/reservations/"+booking.Id)
+	writeJSON(w, http.StatusCreated, bookingJSON(booking))
+}
+
+func (s *Frontend) getReservationHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	ctx, cancel := runtime.WithBudget(r.Context(), s.timeouts.Reservation)
+	defer cancel()
+	booking, err := s.reservationClient.Get(ctx, &reservation.BookingRequest{Id: r.PathValue("id")})
+	if err != nil {
+		writeUpstreamError(w, err, "reservation")
+		return
+	}
+	writeJSON(w, http.StatusOK, bookingJSON(booking))
+}
+
+// cancelReservationHandler cancels a reservation. Cancelling one that is
+// already cancelled returns it unchanged.
+func (s *Frontend) cancelReservationHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	ctx, cancel := runtime.WithBudget(r.Context(), s.timeouts.Reservation)
+	defer cancel()
+	booking, err := s.reservationClient.Cancel(ctx, &reservation.BookingRequest{Id: r.PathValue("id")})
+	if err != nil {
+		writeUpstreamError(w, err, "reservation")
+		return
+	}
+	writeJSON(w, http.StatusOK, bookingJSON(booking))
+}
+
+func bookingJSON(b *reservation.Booking) map[string]interface{} {
+	out := map[string]interface{}{
+		"id":         b.GetId(),
+		"hotel_id":   b.GetHotelId(),
+		"room_code":  b.GetRoomCode(),
+		"in_date":    b.GetInDate(),
+		"out_date":   b.GetOutDate(),
+		"rooms":      b.GetRooms(),
+		"status":     b.GetStatus(),
+		"created_at": b.GetCreatedAt(),
+	}
+	if b.GetExpiresAt() != "" {
+		out["expires_at"] = b.GetExpiresAt()
+	}
+	return out
+}
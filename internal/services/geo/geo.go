@@ -1,25 +1,41 @@
 package geo
 
 import (
-	"encoding/json"
+	"encoding/binary"
 	"fmt"
+	"hash/fnv"
 	"log"
 	"math"
 	"net"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/harlow/go-micro-services/data"
+	"github.com/harlow/go-micro-services/internal/cursor"
+	"github.com/harlow/go-micro-services/internal/dataload"
+	"github.com/harlow/go-micro-services/internal/rpcerr"
 	runtime "github.com/harlow/go-micro-services/internal/runtime"
 	geo "github.com/harlow/go-micro-services/internal/services/geo/proto"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// staleCursor is the precondition violation type of a page request whose
+// cursor was handed out before the hotel locations last changed.
+const staleCursor = "STALE_CURSOR"
+
 const (
-	maxSearchRadius  = 10
-	maxSearchResults = 20
-	earthRadiusKm    = 6371.0
+	defaultSearchRadius  = 10
+	maxSearchRadius      = 50
+	defaultSearchResults = 20
+	maxSearchResults     = 100
+	earthRadiusKm        = 6371.0
 )
 
 // point represents a hotel's geo location on map.
@@ -29,22 +45,91 @@ type point struct {
 	Plon float64 `json:"lon"`
 }
 
-// New returns a new server.
-func New() *Geo {
-	return &Geo{
-		points: loadPoints("data/geo.json"),
+// New returns a new server. When journalPath is set, changes made through
+// UpsertPoints and DeletePoints are appended to that file and replayed over
+// the embedded points on the next start.
+func New(journalPath string) *Geo {
+	points := make(map[string]*point)
+	for _, p := range loadPoints("data/geo.json") {
+		points[p.Pid] = p
+	}
+
+	var j *journal
+	if journalPath != "" {
+		var err error
+		if j, err = openJournal(journalPath, points); err != nil {
+			log.Fatalf("failed to load geo journal: %v", err)
+		}
+	}
+
+	s := &Geo{
+		neighborhoods: loadNeighborhoods("data/neighborhoods.json"),
+		journal:       j,
 	}
+	s.snapshot.Store(newSnapshot(points))
+	return s
+}
+
+// newGeo returns a server over points without a journal.
+func newGeo(points []*point) *Geo {
+	byID := make(map[string]*point, len(points))
+	for _, p := range points {
+		byID[p.Pid] = p
+	}
+
+	s := &Geo{neighborhoods: map[string]*area{}}
+	s.snapshot.Store(newSnapshot(byID))
+	return s
 }
 
 // Geo implements the geo service.
 type Geo struct {
-	points []*point
+	geo.UnimplementedGeoServer
+	neighborhoods map[string]*area
+
+	// snapshot holds the current points. Queries load it once and see a
+	// consistent view; updates build a new snapshot and swap it in.
+	snapshot atomic.Pointer[snapshot]
+	// mu serializes updates and journal writes.
+	mu      sync.Mutex
+	journal *journal
+}
+
+// snapshot is an immutable set of points and their index.
+type snapshot struct {
+	points map[string]*point
+	index  *index
+	// version identifies the points in page cursors, so a cursor handed
+	// out before an update is not used to page through the points after
+	// it. It is derived from the points, so servers with the same points
+	// accept each other's cursors.
+	version string
+}
+
+func newSnapshot(points map[string]*point) *snapshot {
+	list := make([]*point, 0, len(points))
+	for _, p := range points {
+		list = append(list, p)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Pid < list[j].Pid })
+
+	h := fnv.New64a()
+	var buf [8]byte
+	for _, p := range list {
+		h.Write([]byte(p.Pid))
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(p.Plat))
+		h.Write(buf[:])
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(p.Plon))
+		h.Write(buf[:])
+	}
+	return &snapshot{points: points, index: newIndex(list), version: strconv.FormatUint(h.Sum64(), 36)}
 }
 
 // Run starts the server.
 func (s *Geo) Run(port int) error {
 	srv := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(runtime.RejectWhileDraining),
 	)
 	geo.RegisterGeoServer(srv, s)
 
@@ -53,48 +138,280 @@ func (s *Geo) Run(port int) error {
 		return fmt.Errorf("failed to listen: %w", err)
 	}
 
-	return runtime.ServeGRPCGracefully(lis, srv)
+	err = runtime.ServeGRPCGracefully(lis, srv)
+	if s.journal != nil {
+		if cerr := s.journal.Close(); cerr != nil && err == nil {
+			err = fmt.Errorf("close journal: %w", cerr)
+		}
+	}
+	return err
 }
 
-// Nearby returns all hotels within a given distance.
+// Nearby returns all hotels within a given distance, nearest first, one page
+// at a time. In nearest mode it returns the closest hotels whatever their
+// distance instead.
 func (s *Geo) Nearby(ctx context.Context, req *geo.Request) (*geo.Result, error) {
 	_ = ctx
 
-	res := &geo.Result{}
-	for _, p := range s.getNearbyPoints(float64(req.Lat), float64(req.Lon)) {
-		res.HotelIds = append(res.HotelIds, p.Pid)
+	lat, lon, err := requestLocation(req)
+	if err != nil {
+		return nil, err
+	}
+
+	radius := float64(req.RadiusKm)
+	switch {
+	case !inRange(radius, 0, maxSearchRadius):
+		return nil, rpcerr.InvalidArgument("radiusKm", fmt.Sprintf("must be between 0 and %d", maxSearchRadius))
+	case radius == 0:
+		radius = defaultSearchRadius
+	}
+
+	if req.Nearest && req.Cursor != "" {
+		return nil, rpcerr.InvalidArgument("cursor", "nearest results come in a single page")
+	}
+	snap := s.snapshot.Load()
+	offset, limit, err := pageParams(req.Limit, req.Cursor, snap.version)
+	if err != nil {
+		return nil, err
 	}
 
-	return res, nil
+	var points []*point
+	if req.Nearest {
+		points = snap.index.Nearest(lat, lon, limit)
+	} else {
+		points = snap.index.Within(lat, lon, radius)
+	}
+
+	return page(points, offset, limit, lat, lon, snap.version), nil
+}
+
+// requestLocation returns the validated location of req, preferring the
+// double precision fields over the deprecated float ones.
+func requestLocation(req *geo.Request) (float64, float64, error) {
+	latField, lat := "lat", float64(req.Lat)
+	if req.Latitude != nil {
+		latField, lat = "latitude", *req.Latitude
+	}
+	lonField, lon := "lon", float64(req.Lon)
+	if req.Longitude != nil {
+		lonField, lon = "longitude", *req.Longitude
+	}
+
+	if !inRange(lat, -90, 90) {
+		return 0, 0, rpcerr.InvalidArgument(latField, "must be between -90 and 90")
+	}
+	if !inRange(lon, -180, 180) {
+		return 0, 0, rpcerr.InvalidArgument(lonField, "must be between -180 and 180")
+	}
+	return lat, lon, nil
 }
 
-func (s *Geo) getNearbyPoints(lat, lon float64) []*point {
-	type candidate struct {
-		point *point
-		dist  float64
+// inRange reports whether v lies within [min, max]. NaN passes every
+// comparison that rejects it, so it and the infinities are ruled out first.
+func inRange(v, min, max float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0) && v >= min && v <= max
+}
+
+// Within returns the hotels inside a bounding box, nearest its center first,
+// one page at a time.
+func (s *Geo) Within(ctx context.Context, req *geo.BoundingBox) (*geo.Result, error) {
+	_ = ctx
+
+	ne, sw := req.NorthEast, req.SouthWest
+	if ne == nil || sw == nil {
+		return nil, rpcerr.InvalidArgument("northEast", "both corners are required")
+	}
+	for _, c := range []struct {
+		field string
+		loc   *geo.Location
+	}{{"northEast", ne}, {"southWest", sw}} {
+		if !inRange(c.loc.Lat, -90, 90) || !inRange(c.loc.Lon, -180, 180) {
+			return nil, rpcerr.InvalidArgument(c.field, "lat must be between -90 and 90 and lon between -180 and 180")
+		}
+	}
+	if sw.Lat > ne.Lat {
+		return nil, rpcerr.InvalidArgument("southWest", "must lie south of northEast")
 	}
 
-	candidates := make([]candidate, 0, len(s.points))
-	for _, p := range s.points {
-		d := haversineKm(lat, lon, p.Plat, p.Plon)
-		if d <= maxSearchRadius {
-			candidates = append(candidates, candidate{point: p, dist: d})
+	snap := s.snapshot.Load()
+	offset, limit, err := pageParams(req.Limit, req.Cursor, snap.version)
+	if err != nil {
+		return nil, err
+	}
+
+	// the center of a box crossing the antimeridian lies on the far side
+	lat, lon := (sw.Lat+ne.Lat)/2, (sw.Lon+ne.Lon)/2
+	if sw.Lon > ne.Lon {
+		lon += 180
+		if lon > 180 {
+			lon -= 360
 		}
 	}
 
-	sort.Slice(candidates, func(i, j int) bool {
-		return candidates[i].dist < candidates[j].dist
-	})
+	points := snap.index.InBox(sw.Lat, sw.Lon, ne.Lat, ne.Lon, lat, lon)
+	return page(points, offset, limit, lat, lon, snap.version), nil
+}
+
+// InArea returns the hotels inside a polygon or named neighborhood, nearest
+// the center of its bounding box first, one page at a time.
+func (s *Geo) InArea(ctx context.Context, req *geo.AreaRequest) (*geo.Result, error) {
+	_ = ctx
+
+	var a *area
+	switch {
+	case req.Geometry != "" && req.Neighborhood != "":
+		return nil, rpcerr.InvalidArgument("geometry", "cannot be combined with neighborhood")
+	case req.Geometry != "":
+		var err error
+		if a, err = parseArea([]byte(req.Geometry)); err != nil {
+			return nil, rpcerr.InvalidArgument("geometry", err.Error())
+		}
+	case req.Neighborhood != "":
+		var ok bool
+		if a, ok = s.neighborhoods[strings.ToLower(strings.TrimSpace(req.Neighborhood))]; !ok {
+			return nil, rpcerr.NotFound("neighborhood", req.Neighborhood, "unknown neighborhood")
+		}
+	default:
+		return nil, rpcerr.InvalidArgument("geometry", "a geometry or neighborhood is required")
+	}
 
-	if len(candidates) > maxSearchResults {
-		candidates = candidates[:maxSearchResults]
+	snap := s.snapshot.Load()
+	offset, limit, err := pageParams(req.Limit, req.Cursor, snap.version)
+	if err != nil {
+		return nil, err
 	}
 
-	out := make([]*point, 0, len(candidates))
-	for _, c := range candidates {
-		out = append(out, c.point)
+	south, west, north, east := a.Bounds()
+	lat, lon := a.Center()
+	var points []*point
+	for _, p := range snap.index.InBox(south, west, north, east, lat, lon) {
+		if a.Contains(p.Plat, p.Plon) {
+			points = append(points, p)
+		}
 	}
-	return out
+	return page(points, offset, limit, lat, lon, snap.version), nil
+}
+
+// UpsertPoints adds or moves hotel locations. The batch is journaled, when
+// a journal is configured, before queries see it.
+func (s *Geo) UpsertPoints(ctx context.Context, req *geo.UpsertRequest) (*geo.UpdateResult, error) {
+	_ = ctx
+
+	entries := make([]journalEntry, 0, len(req.Points))
+	for i, p := range req.Points {
+		e := journalEntry{Op: opUpsert, HotelID: p.GetHotelId(), Lat: p.GetLat(), Lon: p.GetLon()}
+		if field, msg := e.check(); field != "" {
+			return nil, rpcerr.InvalidArgument(fmt.Sprintf("points[%d].%s", i, field), msg)
+		}
+		entries = append(entries, e)
+	}
+
+	return s.update(entries)
+}
+
+// DeletePoints removes hotel locations. Unknown hotels are ignored.
+func (s *Geo) DeletePoints(ctx context.Context, req *geo.DeleteRequest) (*geo.UpdateResult, error) {
+	_ = ctx
+
+	entries := make([]journalEntry, 0, len(req.HotelIds))
+	for i, id := range req.HotelIds {
+		if id == "" {
+			return nil, rpcerr.InvalidArgument(fmt.Sprintf("hotelIds[%d]", i), "is required")
+		}
+		entries = append(entries, journalEntry{Op: opDelete, HotelID: id})
+	}
+
+	return s.update(entries)
+}
+
+// update journals the entries that change the current points and swaps in
+// a snapshot with them applied.
+func (s *Geo) update(entries []journalEntry) (*geo.UpdateResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cur := s.snapshot.Load()
+	points := make(map[string]*point, len(cur.points))
+	for id, p := range cur.points {
+		points[id] = p
+	}
+
+	var changes []journalEntry
+	for _, e := range entries {
+		old, ok := points[e.HotelID]
+		switch e.Op {
+		case opUpsert:
+			if ok && old.Plat == e.Lat && old.Plon == e.Lon {
+				continue
+			}
+			points[e.HotelID] = &point{Pid: e.HotelID, Plat: e.Lat, Plon: e.Lon}
+		case opDelete:
+			if !ok {
+				continue
+			}
+			delete(points, e.HotelID)
+		}
+		changes = append(changes, e)
+	}
+
+	if len(changes) > 0 {
+		if s.journal != nil {
+			if err := s.journal.Append(changes); err != nil {
+				log.Printf("geo journal write failed: %v", err)
+				return nil, status.Error(codes.Internal, "failed to persist update")
+			}
+		}
+		s.snapshot.Store(newSnapshot(points))
+	}
+
+	return &geo.UpdateResult{Updated: int32(len(changes)), Total: int32(len(points))}, nil
+}
+
+// pageParams validates the page size and decodes the cursor, which must
+// have been handed out for the points at version.
+func pageParams(reqLimit int32, reqCursor, version string) (int, int, error) {
+	limit := int(reqLimit)
+	switch {
+	case limit < 0 || limit > maxSearchResults:
+		return 0, 0, rpcerr.InvalidArgument("limit", fmt.Sprintf("must be between 0 and %d", maxSearchResults))
+	case limit == 0:
+		limit = defaultSearchResults
+	}
+
+	offset, key, err := cursor.Decode(reqCursor)
+	if err != nil {
+		return 0, 0, rpcerr.InvalidArgument("cursor", err.Error())
+	}
+	if reqCursor != "" && key != version {
+		return 0, 0, rpcerr.FailedPrecondition(staleCursor, "cursor", "hotel locations changed since the cursor was handed out, restart from the first page")
+	}
+	return offset, limit, nil
+}
+
+// page returns limit points from offset with their distance from lat/lon.
+// The cursor to the next page is tied to the points at version.
+func page(points []*point, offset, limit int, lat, lon float64, version string) *geo.Result {
+	res := &geo.Result{}
+	if offset >= len(points) {
+		return res
+	}
+	end := offset + limit
+	if end < len(points) {
+		res.NextCursor = cursor.Encode(end, version)
+	} else {
+		end = len(points)
+	}
+	for _, p := range points[offset:end] {
+		res.HotelIds = append(res.HotelIds, p.Pid)
+		res.Points = append(res.Points, &geo.Point{
+			HotelId:    p.Pid,
+			Lat:        p.Plat,
+			Lon:        p.Plon,
+			DistanceKm: haversineKm(lat, lon, p.Plat, p.Plon),
+		})
+	}
+
+	return res
 }
 
 func haversineKm(lat1, lon1, lat2, lon2 float64) float64 {
@@ -107,12 +424,38 @@ func haversineKm(lat1, lon1, lat2, lon2 float64) float64 {
 }
 
 func loadPoints(path string) []*point {
-	file := data.MustAsset(path)
-
-	var points []*point
-	if err := json.Unmarshal(file, &points); err != nil {
+	points, err := parsePoints(path, data.MustAsset(path))
+	if err != nil {
 		log.Fatalf("failed to load geo points: %v", err)
 	}
-
 	return points
 }
+
+// parsePoints reads the hotel locations in file, rejecting unknown fields,
+// coordinates out of range and hotels listed twice.
+func parsePoints(file string, b []byte) ([]*point, error) {
+	records, err := dataload.Records(file, b, func(p *point) error {
+		if err := dataload.Required("hotelId", p.Pid); err != nil {
+			return err
+		}
+		if err := dataload.Range("lat", p.Plat, -90, 90); err != nil {
+			return err
+		}
+		return dataload.Range("lon", p.Plon, -180, 180)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]int, len(records))
+	points := make([]*point, len(records))
+	for i := range records {
+		p := &records[i]
+		if prev, ok := seen[p.Pid]; ok {
+			return nil, &dataload.Error{File: file, Index: i, Field: "hotelId", Msg: fmt.Sprintf("hotel %s already listed at index %d", p.Pid, prev)}
+		}
+		seen[p.Pid] = i
+		points[i] = p
+	}
+	return points, nil
+}
@@ -1,15 +1,33 @@
 package geo
 
-import "testing"
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
 
-func TestGetNearbyPointsSortedByDistance(t *testing.T) {
-	s := &Geo{points: []*point{
+	"github.com/harlow/go-micro-services/data"
+	"github.com/harlow/go-micro-services/internal/cursor"
+	geo "github.com/harlow/go-micro-services/internal/services/geo/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestIndexWithinSortedByDistance(t *testing.T) {
+	idx := newIndex([]*point{
 		{Pid: "a", Plat: 37.7750, Plon: -122.4195},
 		{Pid: "b", Plat: 37.7850, Plon: -122.4095},
 		{Pid: "c", Plat: 37.8050, Plon: -122.3895},
-	}}
+	})
 
-	got := s.getNearbyPoints(37.7749, -122.4194)
+	got := idx.Within(37.7749, -122.4194, defaultSearchRadius)
 	if len(got) < 2 {
 		t.Fatalf("expected at least 2 nearby points, got %d", len(got))
 	}
@@ -20,3 +38,496 @@ func TestGetNearbyPointsSortedByDistance(t *testing.T) {
 		t.Fatalf("expected second closest point 'b', got %q", got[1].Pid)
 	}
 }
+
+func TestIndexWithinRespectsRadius(t *testing.T) {
+	idx := newIndex([]*point{
+		{Pid: "a", Plat: 37.7750, Plon: -122.4195},
+		{Pid: "b", Plat: 37.8050, Plon: -122.3895},
+	})
+
+	got := idx.Within(37.7749, -122.4194, 1)
+	if len(got) != 1 || got[0].Pid != "a" {
+		t.Fatalf("expected only point 'a' within 1km, got %d points", len(got))
+	}
+}
+
+func TestNearbyPagesThroughResults(t *testing.T) {
+	s := newGeo([]*point{
+		{Pid: "c", Plat: 37.8050, Plon: -122.3895},
+		{Pid: "a", Plat: 37.7750, Plon: -122.4195},
+		{Pid: "b", Plat: 37.7850, Plon: -122.4095},
+	})
+
+	var got []string
+	req := &geo.Request{Lat: 37.7749, Lon: -122.4194, Limit: 2}
+	for {
+		res, err := s.Nearby(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Nearby returned error: %v", err)
+		}
+		got = append(got, res.HotelIds...)
+		if res.NextCursor == "" {
+			break
+		}
+		req.Cursor = res.NextCursor
+	}
+
+	if len(got) != 3 || got[0] != "a" || got[1] != "b" || got[2] != "c" {
+		t.Fatalf("unexpected hotel ids across pages: %v", got)
+	}
+}
+
+func TestCursorsExpireWhenLocationsChange(t *testing.T) {
+	points := []*point{
+		{Pid: "a", Plat: 37.7750, Plon: -122.4195},
+		{Pid: "b", Plat: 37.7850, Plon: -122.4095},
+		{Pid: "c", Plat: 37.8050, Plon: -122.3895},
+	}
+	s := newGeo(points)
+	req := &geo.Request{Lat: 37.7749, Lon: -122.4194, Limit: 2}
+	res, err := s.Nearby(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	req.Cursor = res.NextCursor
+
+	// a server with the same points accepts the cursor
+	if _, err := newGeo(points).Nearby(context.Background(), req); err != nil {
+		t.Fatalf("Nearby on another server returned error: %v", err)
+	}
+
+	if _, err := s.UpsertPoints(context.Background(), &geo.UpsertRequest{Points: []*geo.Point{{HotelId: "d", Lat: 37.776, Lon: -122.419}}}); err != nil {
+		t.Fatalf("UpsertPoints returned error: %v", err)
+	}
+	_, err = s.Nearby(context.Background(), req)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("error code = %v, want FailedPrecondition", status.Code(err))
+	}
+	_, err = s.Within(context.Background(), &geo.BoundingBox{
+		NorthEast: &geo.Location{Lat: 37.9, Lon: -122.3},
+		SouthWest: &geo.Location{Lat: 37.7, Lon: -122.5},
+		Cursor:    req.Cursor,
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Within error code = %v, want FailedPrecondition", status.Code(err))
+	}
+}
+
+func TestNearbyValidatesRadiusAndLimit(t *testing.T) {
+	s := newGeo(nil)
+	nan, inf := math.NaN(), math.Inf(1)
+
+	for _, req := range []*geo.Request{
+		{RadiusKm: -1},
+		{RadiusKm: maxSearchRadius + 1},
+		{RadiusKm: float32(nan)},
+		{Lat: float32(nan)},
+		{Lon: float32(nan)},
+		{Latitude: &nan},
+		{Longitude: &inf},
+		{Limit: -1},
+		{Limit: maxSearchResults + 1},
+		{Nearest: true, Cursor: cursor.Encode(1, "")},
+	} {
+		_, err := s.Nearby(context.Background(), req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%v: error code = %v, want InvalidArgument", req, status.Code(err))
+		}
+	}
+}
+
+func TestNearbyPrefersDoubleLocation(t *testing.T) {
+	// two hotels 5m apart, closer together than float32 can tell apart
+	s := newGeo([]*point{
+		{Pid: "a", Plat: 37.78670, Plon: -122.41120},
+		{Pid: "b", Plat: 37.78675, Plon: -122.41120},
+	})
+
+	res, err := s.Nearby(context.Background(), &geo.Request{
+		Lat:       37.7867,
+		Lon:       -122.4112,
+		Latitude:  proto.Float64(37.78675),
+		Longitude: proto.Float64(-122.41120),
+	})
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	if res.HotelIds[0] != "b" || res.Points[0].DistanceKm > 1e-9 {
+		t.Fatalf("nearest = %s at %vkm, want b at 0km", res.HotelIds[0], res.Points[0].DistanceKm)
+	}
+
+	_, err = s.Nearby(context.Background(), &geo.Request{Latitude: proto.Float64(91)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("error code = %v, want InvalidArgument", status.Code(err))
+	}
+}
+
+func TestNearbyNearestIgnoresRadius(t *testing.T) {
+	s := newGeo([]*point{
+		{Pid: "sf", Plat: 37.7750, Plon: -122.4195},
+		{Pid: "la", Plat: 34.0522, Plon: -118.2437},
+		{Pid: "ny", Plat: 40.7128, Plon: -74.0060},
+	})
+
+	// rural Nevada, hundreds of kilometers from any hotel
+	res, err := s.Nearby(context.Background(), &geo.Request{Lat: 38.8, Lon: -116.4, Limit: 2, Nearest: true})
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	if !reflect.DeepEqual(res.HotelIds, []string{"sf", "la"}) || res.NextCursor != "" {
+		t.Fatalf("unexpected result: %v", res)
+	}
+	if d := res.Points[0].DistanceKm; d <= maxSearchRadius {
+		t.Fatalf("distance to sf = %v, want beyond the search radius", d)
+	}
+}
+
+func TestIndexNearestMatchesScan(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	points := randomPoints(rng, 5000, -90, 90, -180, 180)
+	idx := newIndex(points)
+
+	for i := 0; i < 50; i++ {
+		lat, lon := rng.Float64()*180-90, rng.Float64()*360-180
+		got := idx.Nearest(lat, lon, 10)
+		want := scanWithin(points, lat, lon, math.Pi*earthRadiusKm)[:10]
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Nearest(%v, %v) disagrees with a full scan", lat, lon)
+		}
+	}
+}
+
+func TestWithinReturnsViewportNearestCenterFirst(t *testing.T) {
+	s := newGeo([]*point{
+		{Pid: "edge", Plat: 37.79, Plon: -122.49},
+		{Pid: "center", Plat: 37.75, Plon: -122.40},
+		{Pid: "outside", Plat: 37.90, Plon: -122.40},
+		{Pid: "fiji-east", Plat: -17, Plon: -179.9},
+		{Pid: "fiji-west", Plat: -17, Plon: 179.8},
+		{Pid: "dateline", Plat: -17, Plon: 180},
+	})
+
+	tests := []struct {
+		ne, sw *geo.Location
+		want   []string
+	}{
+		{&geo.Location{Lat: 37.8, Lon: -122.3}, &geo.Location{Lat: 37.7, Lon: -122.5}, []string{"center", "edge"}},
+		{&geo.Location{Lat: -16, Lon: -179.5}, &geo.Location{Lat: -18, Lon: 179.5}, []string{"dateline", "fiji-east", "fiji-west"}},
+		// a box ending on the antimeridian includes the points on it
+		{&geo.Location{Lat: -16, Lon: 180}, &geo.Location{Lat: -18, Lon: 179.5}, []string{"fiji-west", "dateline"}},
+	}
+	for _, tt := range tests {
+		res, err := s.Within(context.Background(), &geo.BoundingBox{NorthEast: tt.ne, SouthWest: tt.sw})
+		if err != nil {
+			t.Fatalf("Within returned error: %v", err)
+		}
+		if !reflect.DeepEqual(res.HotelIds, tt.want) {
+			t.Fatalf("Within(%v, %v) = %v, want %v", tt.ne, tt.sw, res.HotelIds, tt.want)
+		}
+	}
+
+	for _, box := range []*geo.BoundingBox{
+		{NorthEast: &geo.Location{Lat: 37.7, Lon: -122.3}, SouthWest: &geo.Location{Lat: 37.8, Lon: -122.5}},
+		{NorthEast: &geo.Location{Lat: math.NaN(), Lon: -122.3}, SouthWest: &geo.Location{Lat: 37.7, Lon: -122.5}},
+	} {
+		_, err := s.Within(context.Background(), box)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%v: error code = %v, want InvalidArgument", box, status.Code(err))
+		}
+	}
+}
+
+func TestInAreaMatchesPolygonsAndNeighborhoods(t *testing.T) {
+	neighborhoods, err := parseNeighborhoods("data/neighborhoods.json", data.MustAsset("data/neighborhoods.json"))
+	if err != nil {
+		t.Fatalf("parseNeighborhoods: %v", err)
+	}
+	s := newGeo([]*point{
+		{Pid: "union-square", Plat: 37.7880, Plon: -122.4075},
+		{Pid: "mission", Plat: 37.7599, Plon: -122.4148},
+		{Pid: "fiji-east", Plat: -17, Plon: -179.9},
+		{Pid: "fiji-west", Plat: -17, Plon: 179.8},
+		{Pid: "fiji-hole", Plat: -17, Plon: 179.95},
+	})
+	s.neighborhoods = neighborhoods
+
+	tests := []struct {
+		req  *geo.AreaRequest
+		want []string
+	}{
+		{&geo.AreaRequest{Neighborhood: "mission district"}, []string{"mission"}},
+		{&geo.AreaRequest{Neighborhood: "Union Square"}, []string{"union-square"}},
+		// a ring crossing the antimeridian, with a hole around fiji-hole
+		{&geo.AreaRequest{Geometry: `{"type":"Polygon","coordinates":[
+			[[179.5,-18],[-179.5,-18],[-179.5,-16],[179.5,-16],[179.5,-18]],
+			[[179.9,-17.1],[179.99,-17.1],[179.99,-16.9],[179.9,-16.9],[179.9,-17.1]]
+		]}`}, []string{"fiji-east", "fiji-west"}},
+		// the same area split at the antimeridian
+		{&geo.AreaRequest{Geometry: `{"type":"MultiPolygon","coordinates":[
+			[[[179.5,-18],[180,-18],[180,-16],[179.5,-16],[179.5,-18]]],
+			[[[-180,-18],[-179.5,-18],[-179.5,-16],[-180,-16],[-180,-18]]]
+		]}`}, []string{"fiji-east", "fiji-hole", "fiji-west"}},
+	}
+	for _, tt := range tests {
+		res, err := s.InArea(context.Background(), tt.req)
+		if err != nil {
+			t.Fatalf("%v: InArea returned error: %v", tt.req, err)
+		}
+		got := append([]string(nil), res.HotelIds...)
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("%v: got %v, want %v", tt.req, got, tt.want)
+		}
+	}
+
+	for _, tt := range []struct {
+		req  *geo.AreaRequest
+		code codes.Code
+	}{
+		{&geo.AreaRequest{}, codes.InvalidArgument},
+		{&geo.AreaRequest{Geometry: `{"type":"Point","coordinates":[0,0]}`}, codes.InvalidArgument},
+		{&geo.AreaRequest{Geometry: `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}`}, codes.InvalidArgument},
+		{&geo.AreaRequest{Neighborhood: "Atlantis"}, codes.NotFound},
+	} {
+		_, err := s.InArea(context.Background(), tt.req)
+		if status.Code(err) != tt.code {
+			t.Fatalf("%v: error code = %v, want %v", tt.req, status.Code(err), tt.code)
+		}
+	}
+}
+
+func TestParseNeighborhoodsValidatesFeatures(t *testing.T) {
+	const square = `{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 1], [0, 0]]]}`
+	for _, tt := range []struct {
+		in, want string
+	}{
+		{`{"type": "FeatureCollection", "features": [], "bbox": [0, 0, 1, 1]}`, "n.json.bbox: unknown field"},
+		{`{"type": "Feature"}`, `n.json.type: "Feature" is not a FeatureCollection`},
+		{`{"type": "FeatureCollection", "features": [{"type": "Feature", "properties": {"name": "Soma", "area": 3}, "geometry": ` + square + `}]}`, "n.json.features[0].properties.area: unknown field"},
+		{`{"type": "FeatureCollection", "features": [{"type": "Feature", "properties": {}, "geometry": ` + square + `}]}`, "n.json.features[0].properties.name: is required"},
+		{`{"type": "FeatureCollection", "features": [{"type": "Feature", "properties": {"name": "Soma"}, "geometry": {"type": "Point", "coordinates": [0, 0]}}]}`, `n.json.features[0].geometry: unsupported geometry type "Point", expected Polygon or MultiPolygon`},
+		{`{"type": "FeatureCollection", "features": [
+			{"type": "Feature", "properties": {"name": "Soma"}, "geometry": ` + square + `},
+			{"type": "Feature", "properties": {"name": "SOMA "}, "geometry": ` + square + `}
+		]}`, `n.json.features[1].properties.name: neighborhood "SOMA " already listed at index 0`},
+	} {
+		_, err := parseNeighborhoods("n.json", []byte(tt.in))
+		if err == nil || err.Error() != tt.want {
+			t.Fatalf("%s: error = %v, want %q", tt.in, err, tt.want)
+		}
+	}
+}
+
+func TestUpdatesAreJournaledAndReplayed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "geo.journal")
+	j, err := openJournal(path, map[string]*point{})
+	if err != nil {
+		t.Fatalf("openJournal: %v", err)
+	}
+	s := newGeo([]*point{
+		{Pid: "a", Plat: 37.7750, Plon: -122.4195},
+		{Pid: "b", Plat: 37.7850, Plon: -122.4095},
+	})
+	s.journal = j
+
+	before := s.snapshot.Load()
+	res, err := s.UpsertPoints(context.Background(), &geo.UpsertRequest{Points: []*geo.Point{
+		{HotelId: "a", Lat: 37.7750, Lon: -122.4195},
+		{HotelId: "c", Lat: 37.7751, Lon: -122.4196},
+	}})
+	if err != nil {
+		t.Fatalf("UpsertPoints returned error: %v", err)
+	}
+	if res.Updated != 1 || res.Total != 3 {
+		t.Fatalf("unexpected upsert result: %v", res)
+	}
+	if _, err := s.DeletePoints(context.Background(), &geo.DeleteRequest{HotelIds: []string{"b", "missing"}}); err != nil {
+		t.Fatalf("DeletePoints returned error: %v", err)
+	}
+	if len(before.points) != 2 || len(before.index.Within(37.7749, -122.4194, defaultSearchRadius)) != 2 {
+		t.Fatalf("updates changed an earlier snapshot")
+	}
+
+	nearby, err := s.Nearby(context.Background(), &geo.Request{Lat: 37.7749, Lon: -122.4194})
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	if !reflect.DeepEqual(nearby.HotelIds, []string{"a", "c"}) {
+		t.Fatalf("nearby after updates = %v, want [a c]", nearby.HotelIds)
+	}
+	j.Close()
+
+	// a crash mid-write leaves a torn last line, which replay drops
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatalf("open journal: %v", err)
+	}
+	f.WriteString(`{"op":"delete","hot`)
+	f.Close()
+
+	points := map[string]*point{"a": {Pid: "a"}, "b": {Pid: "b"}}
+	j, err = openJournal(path, points)
+	if err != nil {
+		t.Fatalf("reopen journal: %v", err)
+	}
+	defer j.Close()
+	if len(points) != 2 || points["b"] != nil || points["c"].Plat != 37.7751 {
+		t.Fatalf("unexpected replayed points: %v", points)
+	}
+}
+
+func TestUpsertPointsValidates(t *testing.T) {
+	s := newGeo(nil)
+	for _, p := range []*geo.Point{
+		{},
+		{HotelId: "a", Lat: 91},
+		{HotelId: "a", Lon: -181},
+		{HotelId: "a", Lat: math.NaN()},
+		{HotelId: "a", Lon: math.Inf(1)},
+	} {
+		_, err := s.UpsertPoints(context.Background(), &geo.UpsertRequest{Points: []*geo.Point{p}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%v: error code = %v, want InvalidArgument", p, status.Code(err))
+		}
+	}
+}
+
+func TestReplayRejectsInvalidEntries(t *testing.T) {
+	for _, tt := range []struct {
+		in, want string
+	}{
+		{`{"op":"upsert","hotelId":"a","lat":37.7,"lon":-222.4}` + "\n", "line 1: lon must be between -180 and 180"},
+		{`{"op":"delete","hotelId":"a"}` + "\n" + `{"op":"upsert","lat":37.7,"lon":-122.4}` + "\n", "line 2: hotelId is required"},
+	} {
+		points := map[string]*point{"a": {Pid: "a"}}
+		_, err := replay(strings.NewReader(tt.in), points)
+		if err == nil || err.Error() != tt.want {
+			t.Fatalf("%s: error = %v, want %q", tt.in, err, tt.want)
+		}
+	}
+}
+
+func TestIndexWithinMatchesScan(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	points := randomPoints(rng, 20000, -90, 90, -180, 180)
+	// crowd the poles and the antimeridian, where cell bounds wrap
+	points = append(points, randomPoints(rng, 2000, 89, 90, -180, 180)...)
+	points = append(points, randomPoints(rng, 2000, -10, 10, 179, 180)...)
+	points = append(points, randomPoints(rng, 2000, -10, 10, -180, -179)...)
+	idx := newIndex(points)
+
+	queries := [][3]float64{
+		{37.7749, -122.4194, 50},
+		{0, 180, 50},
+		{0, -180, 30},
+		{5, 179.99, 50},
+		{89.9, 0, 50},
+		{-89.9, 45, 50},
+	}
+	for i := 0; i < 200; i++ {
+		queries = append(queries, [3]float64{rng.Float64()*180 - 90, rng.Float64()*360 - 180, rng.Float64() * maxSearchRadius})
+	}
+
+	for _, q := range queries {
+		got := idx.Within(q[0], q[1], q[2])
+		want := scanWithin(points, q[0], q[1], q[2])
+		if len(got) == 0 && len(want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Within(%v, %v, %v) returned %d points, scan found %d", q[0], q[1], q[2], len(got), len(want))
+		}
+	}
+}
+
+// benchmarkDensity is the points per square degree loaded by the
+// benchmarks, which puts about five points within 10km of a query near the
+// equator.
+const benchmarkDensity = 200
+
+// BenchmarkIndexWithin queries 10km around random spots of an area that
+// grows with the points loaded, so every query matches about the same
+// number of points whatever n. The index measures the same few cells at
+// every n, as its allocations show; what time it gains with n comes from the
+// grid outgrowing the CPU caches. BenchmarkScanWithin grows linearly.
+func BenchmarkIndexWithin(b *testing.B) {
+	for _, n := range []int{10000, 100000, 1000000} {
+		rng := rand.New(rand.NewSource(1))
+		half := benchmarkSpan(n) / 2
+		idx := newIndex(randomPoints(rng, n, -half, half, -half, half))
+		b.Run(fmt.Sprintf("points=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				idx.Within(-half+rng.Float64()*2*half, -half+rng.Float64()*2*half, defaultSearchRadius)
+			}
+		})
+	}
+}
+
+func BenchmarkScanWithin(b *testing.B) {
+	for _, n := range []int{10000, 100000, 1000000} {
+		rng := rand.New(rand.NewSource(1))
+		half := benchmarkSpan(n) / 2
+		points := randomPoints(rng, n, -half, half, -half, half)
+		b.Run(fmt.Sprintf("points=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				scanWithin(points, -half+rng.Float64()*2*half, -half+rng.Float64()*2*half, defaultSearchRadius)
+			}
+		})
+	}
+}
+
+// benchmarkSpan returns the side in degrees of the square, centered on the
+// equator, holding n points at benchmarkDensity.
+func benchmarkSpan(n int) float64 {
+	return math.Sqrt(float64(n) / benchmarkDensity)
+}
+
+func randomPoints(rng *rand.Rand, n int, minLat, maxLat, minLon, maxLon float64) []*point {
+	points := make([]*point, n)
+	for i := range points {
+		points[i] = &point{
+			Pid:  fmt.Sprintf("%v,%v-%d", minLat, minLon, i),
+			Plat: minLat + rng.Float64()*(maxLat-minLat),
+			Plon: minLon + rng.Float64()*(maxLon-minLon),
+		}
+	}
+	return points
+}
+
+// scanWithin is the exhaustive search the index replaces.
+func scanWithin(points []*point, lat, lon, radius float64) []*point {
+	var out []*point
+	for _, p := range points {
+		if haversineKm(lat, lon, p.Plat, p.Plon) <= radius {
+			out = append(out, p)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		di, dj := haversineKm(lat, lon, out[i].Plat, out[i].Plon), haversineKm(lat, lon, out[j].Plat, out[j].Plon)
+		if di != dj {
+			return di < dj
+		}
+		return out[i].Pid < out[j].Pid
+	})
+	return out
+}
+
+func TestParsePointsValidatesRecords(t *testing.T) {
+	if _, err := parsePoints("data/geo.json", data.MustAsset("data/geo.json")); err != nil {
+		t.Fatalf("embedded points are invalid: %v", err)
+	}
+
+	for _, tt := range []struct {
+		in, want string
+	}{
+		{`[{"hotelId": "1", "lat": 37.78, "lon": -122.41, "name": "Clift"}]`, "geo.json[0].name: unknown field"},
+		{`[{"hotelId": "1", "lat": 97.78, "lon": -122.41}]`, "geo.json[0].lat: 97.78 is out of range [-90, 90]"},
+		{`[{"hotelId": "1", "lat": 1, "lon": 1}, {"hotelId": "1", "lat": 2, "lon": 2}]`, "geo.json[1].hotelId: hotel 1 already listed at index 0"},
+	} {
+		_, err := parsePoints("geo.json", []byte(tt.in))
+		if err == nil || err.Error() != tt.want {
+			t.Fatalf("%s: error = %v, want %q", tt.in, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package geo
+
+import (
+	"math"
+	"sort"
+)
+
+// cellDeg is the edge length of a grid cell in degrees, roughly 11km of
+// latitude, so the largest search radius touches a few dozen cells.
+const cellDeg = 0.1
+
+var (
+	latCells = int(180 / cellDeg)
+	lonCells = int(360 / cellDeg)
+)
+
+// cell identifies one grid cell by its latitude row and longitude column.
+type cell struct {
+	row, col int
+}
+
+// index is a fixed grid of lat/lon cells over the hotel points. A radius
+// query only measures the points in cells overlapping the search circle's
+// bounding box instead of every point loaded.
+type index struct {
+	cells map[cell][]*point
+}
+
+// newIndex buckets points into grid cells.
+func newIndex(points []*point) *index {
+	idx := &index{cells: make(map[cell][]*point)}
+	for _, p := range points {
+		c := cellOf(p.Plat, p.Plon)
+		idx.cells[c] = append(idx.cells[c], p)
+	}
+	return idx
+}
+
+// Within returns the points within radius km of lat/lon, nearest first.
+// Points at the same distance are ordered by hotel id.
+func (idx *index) Within(lat, lon, radius float64) []*point {
+	var candidates []candidate
+	idx.visit(lat, lon, radius, func(p *point) {
+		if d := haversineKm(lat, lon, p.Plat, p.Plon); d <= radius {
+			candidates = append(candidates, candidate{point: p, dist: d})
+		}
+	})
+
+	return nearestFirst(candidates)
+}
+
+// Nearest returns the k points closest to lat/lon whatever their distance,
+// nearest first. It widens a radius search until enough points are found.
+func (idx *index) Nearest(lat, lon float64, k int) []*point {
+	for radius := float64(defaultSearchRadius); ; radius *= 2 {
+		// half the earth's circumference reaches every point
+		if radius >= math.Pi*earthRadiusKm {
+			radius = math.Pi * earthRadiusKm
+		}
+		points := idx.Within(lat, lon, radius)
+		if len(points) >= k || radius == math.Pi*earthRadiusKm {
+			if len(points) > k {
+				points = points[:k]
+			}
+			return points
+		}
+	}
+}
+
+// visit calls fn for every point in the cells overlapping the bounding box
+// of the circle of radius km around lat/lon.
+func (idx *index) visit(lat, lon, radius float64, fn func(*point)) {
+	// angular radius of the circle, and its extent in latitude
+	delta := radius / earthRadiusKm
+	dLat := delta * 180 / math.Pi
+
+	minRow := latRow(math.Max(lat-dLat, -90))
+	maxRow := latRow(math.Min(lat+dLat, 90))
+
+	// the widest longitude offset of the circle; when the circle reaches a
+	// pole every column is in range
+	minCol, maxCol := 0, lonCells-1
+	if lat+dLat < 90 && lat-dLat > -90 {
+		if s := math.Sin(delta) / math.Cos(lat*math.Pi/180); s < 1 {
+			dLon := math.Asin(s) * 180 / math.Pi
+			minCol = lonCol(lon - dLon)
+			maxCol = lonCol(lon + dLon)
+			if maxCol < minCol {
+				// the box crosses the antimeridian
+				maxCol += lonCells
+			}
+		}
+	}
+
+	idx.visitCells(minRow, maxRow, minCol, maxCol, fn)
+}
+
+// InBox returns the points inside the box bounded by south/north latitudes
+// and west/east longitudes, nearest lat/lon first. A west edge east of the
+// east edge wraps across the antimeridian.
+func (idx *index) InBox(south, west, north, east, lat, lon float64) []*point {
+	minCol, maxCol := lonCol(west), lonCol(east)
+	if west > east {
+		maxCol += lonCells
+	}
+
+	var candidates []candidate
+	idx.visitCells(latRow(south), latRow(north), minCol, maxCol, func(p *point) {
+		if p.Plat < south || p.Plat > north || !lonBetween(p.Plon, west, east) {
+			return
+		}
+		candidates = append(candidates, candidate{point: p, dist: haversineKm(lat, lon, p.Plat, p.Plon)})
+	})
+
+	return nearestFirst(candidates)
+}
+
+// candidate is a point matched by a query and its distance from the query
+// location.
+type candidate struct {
+	point *point
+	dist  float64
+}
+
+// nearestFirst orders candidates by distance, then by hotel id, and returns
+// their points.
+func nearestFirst(candidates []candidate) []*point {
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].dist != candidates[j].dist {
+			return candidates[i].dist < candidates[j].dist
+		}
+		return candidates[i].point.Pid < candidates[j].point.Pid
+	})
+
+	out := make([]*point, 0, len(candidates))
+	for _, c := range candidates {
+		out = append(out, c.point)
+	}
+	return out
+}
+
+// visitCells calls fn for every point in the rows and columns given. maxCol
+// may run past the last column to wrap across the antimeridian.
+func (idx *index) visitCells(minRow, maxRow, minCol, maxCol int, fn func(*point)) {
+	// wide boxes cover more cells than are occupied, so walk those instead
+	if (maxRow-minRow+1)*(maxCol-minCol+1) > len(idx.cells) {
+		for c, points := range idx.cells {
+			if c.row < minRow || c.row > maxRow {
+				continue
+			}
+			if col := c.col; (col < minCol || col > maxCol) && (col+lonCells < minCol || col+lonCells > maxCol) {
+				continue
+			}
+			for _, p := range points {
+				fn(p)
+			}
+		}
+		return
+	}
+
+	for row := minRow; row <= maxRow; row++ {
+		for col := minCol; col <= maxCol; col++ {
+			for _, p := range idx.cells[cell{row: row, col: col % lonCells}] {
+				fn(p)
+			}
+		}
+	}
+}
+
+func lonBetween(lon, west, east float64) bool {
+	if west <= east {
+		return lon >= west && lon <= east
+	}
+	return lon >= west || lon <= east
+}
+
+func cellOf(lat, lon float64) cell {
+	return cell{row: latRow(lat), col: lonCol(lon)}
+}
+
+func latRow(lat float64) int {
+	row := int(math.Floor((lat + 90) / cellDeg))
+	if row < 0 {
+		return 0
+	}
+	if row >= latCells {
+		return latCells - 1
+	}
+	return row
+}
+
+// lonCol returns the column of a longitude, wrapping longitudes past the
+// antimeridian. 180 itself belongs to the last column, so boxes ending
+// there cover it.
+func lonCol(lon float64) int {
+	if lon == 180 {
+		return lonCells - 1
+	}
+	col := int(math.Floor((lon + 180) / cellDeg))
+	col %= lonCells
+	if col < 0 {
+		col += lonCells
+	}
+	return col
+}
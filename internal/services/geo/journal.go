@@ -0,0 +1,145 @@
+package geo
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+)
+
+// journalEntry is one line of the journal: an upsert or a delete of a
+// hotel's location.
+type journalEntry struct {
+	Op      string  `json:"op"`
+	HotelID string  `json:"hotelId"`
+	Lat     float64 `json:"lat,omitempty"`
+	Lon     float64 `json:"lon,omitempty"`
+}
+
+const (
+	opUpsert = "upsert"
+	opDelete = "delete"
+)
+
+// check returns the field of an entry that cannot be applied and why, or
+// an empty field when the entry is valid. Updates and journal replays both
+// check their entries, so a bad location never reaches the index.
+func (e journalEntry) check() (field, msg string) {
+	if e.HotelID == "" {
+		return "hotelId", "is required"
+	}
+	if e.Op != opUpsert {
+		return "", ""
+	}
+	switch {
+	case !inRange(e.Lat, -90, 90):
+		return "lat", "must be between -90 and 90"
+	case !inRange(e.Lon, -180, 180):
+		return "lon", "must be between -180 and 180"
+	}
+	return "", ""
+}
+
+// journal is an append-only file of JSON lines recording every change made
+// to the points at runtime. Replaying it over the embedded points on start
+// restores the changes without rebuilding the binary.
+type journal struct {
+	f    *os.File
+	size int64
+}
+
+// openJournal replays the journal at path onto points and opens it for
+// appending, creating it if needed.
+func openJournal(path string, points map[string]*point) (*journal, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open journal: %w", err)
+	}
+
+	n, err := replay(f, points)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("replay journal %s: %w", path, err)
+	}
+
+	// drop a torn last line left by a crash mid-write
+	if err := f.Truncate(n); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("truncate journal: %w", err)
+	}
+	if _, err := f.Seek(n, io.SeekStart); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("seek journal: %w", err)
+	}
+	return &journal{f: f, size: n}, nil
+}
+
+// replay applies the entries read from r to points and returns the length
+// of the complete entries read. An unterminated last line is skipped.
+func replay(r io.Reader, points map[string]*point) (int64, error) {
+	br := bufio.NewReader(r)
+	var n int64
+	for line := 1; ; line++ {
+		b, err := br.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(b) > 0 {
+				log.Printf("geo journal: ignoring incomplete entry on line %d", line)
+			}
+			return n, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		var e journalEntry
+		if err := json.Unmarshal(b, &e); err != nil {
+			return 0, fmt.Errorf("line %d: %w", line, err)
+		}
+		if field, msg := e.check(); field != "" {
+			return 0, fmt.Errorf("line %d: %s %s", line, field, msg)
+		}
+		switch e.Op {
+		case opUpsert:
+			points[e.HotelID] = &point{Pid: e.HotelID, Plat: e.Lat, Plon: e.Lon}
+		case opDelete:
+			delete(points, e.HotelID)
+		default:
+			return 0, fmt.Errorf("line %d: unknown op %q", line, e.Op)
+		}
+		n += int64(len(b))
+	}
+}
+
+// Append writes entries to the journal and syncs it to disk.
+func (j *journal) Append(entries []journalEntry) error {
+	var buf []byte
+	for _, e := range entries {
+		b, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		buf = append(append(buf, b...), '\n')
+	}
+
+	_, err := j.f.Write(buf)
+	if err == nil {
+		err = j.f.Sync()
+	}
+	if err != nil {
+		// cut off whatever part of the batch made it to the file
+		if terr := j.f.Truncate(j.size); terr == nil {
+			_, _ = j.f.Seek(j.size, io.SeekStart)
+		}
+		return err
+	}
+	j.size += int64(len(buf))
+	return nil
+}
+
+// Close closes the journal file.
+func (j *journal) Close() error {
+	return j.f.Close()
+}
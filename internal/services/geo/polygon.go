@@ -0,0 +1,252 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+
+	"github.com/harlow/go-micro-services/data"
+	"github.com/harlow/go-micro-services/internal/dataload"
+)
+
+// area is a GeoJSON Polygon or MultiPolygon. Each polygon is a list of
+// rings, the first being the outer boundary and the rest holes.
+//
+// Ring longitudes are unwrapped so consecutive vertices never differ by
+// more than 180 degrees. A ring crossing the antimeridian then runs past
+// -180 or 180 instead of jumping across the map.
+type area struct {
+	polygons [][][][2]float64
+	// bounding box of the unwrapped rings
+	south, west, north, east float64
+}
+
+// geometry is the subset of a GeoJSON geometry object read by parseArea.
+type geometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// parseArea reads a GeoJSON Polygon or MultiPolygon geometry.
+func parseArea(b []byte) (*area, error) {
+	var g geometry
+	if err := json.Unmarshal(b, &g); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+	return g.area()
+}
+
+func (g geometry) area() (*area, error) {
+	var polygons [][][][]float64
+	switch g.Type {
+	case "Polygon":
+		var rings [][][]float64
+		if err := json.Unmarshal(g.Coordinates, &rings); err != nil {
+			return nil, fmt.Errorf("invalid Polygon coordinates: %w", err)
+		}
+		polygons = [][][][]float64{rings}
+	case "MultiPolygon":
+		if err := json.Unmarshal(g.Coordinates, &polygons); err != nil {
+			return nil, fmt.Errorf("invalid MultiPolygon coordinates: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported geometry type %q, expected Polygon or MultiPolygon", g.Type)
+	}
+	if len(polygons) == 0 {
+		return nil, fmt.Errorf("geometry has no polygons")
+	}
+
+	a := &area{south: 90, north: -90, west: math.Inf(1), east: math.Inf(-1)}
+	for _, rings := range polygons {
+		if len(rings) == 0 {
+			return nil, fmt.Errorf("polygon has no rings")
+		}
+		var polygon [][][2]float64
+		for _, positions := range rings {
+			ring, err := unwrapRing(positions)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range ring {
+				a.south, a.north = math.Min(a.south, v[1]), math.Max(a.north, v[1])
+				a.west, a.east = math.Min(a.west, v[0]), math.Max(a.east, v[0])
+			}
+			polygon = append(polygon, ring)
+		}
+		a.polygons = append(a.polygons, polygon)
+	}
+	return a, nil
+}
+
+// unwrapRing validates a linear ring of [lon, lat] positions and unwraps
+// its longitudes.
+func unwrapRing(positions [][]float64) ([][2]float64, error) {
+	if len(positions) < 4 {
+		return nil, fmt.Errorf("ring needs at least 4 positions, got %d", len(positions))
+	}
+
+	ring := make([][2]float64, 0, len(positions))
+	for _, pos := range positions {
+		if len(pos) < 2 {
+			return nil, fmt.Errorf("position needs a longitude and a latitude")
+		}
+		lon, lat := pos[0], pos[1]
+		if lon < -180 || lon > 180 || lat < -90 || lat > 90 {
+			return nil, fmt.Errorf("position [%v, %v] is out of range", lon, lat)
+		}
+		if n := len(ring); n > 0 {
+			prev := ring[n-1][0]
+			for lon-prev > 180 {
+				lon -= 360
+			}
+			for prev-lon > 180 {
+				lon += 360
+			}
+		}
+		ring = append(ring, [2]float64{lon, lat})
+	}
+	if first, last := ring[0], ring[len(ring)-1]; first[1] != last[1] || math.Mod(first[0]-last[0], 360) != 0 {
+		return nil, fmt.Errorf("ring is not closed")
+	}
+	return ring, nil
+}
+
+// Contains reports whether lat/lon lies inside the area. Points inside a
+// hole are outside.
+func (a *area) Contains(lat, lon float64) bool {
+	// the unwrapped rings may extend past the antimeridian, so try the
+	// point's other representations too
+	for _, l := range []float64{lon, lon - 360, lon + 360} {
+		if l < a.west || l > a.east {
+			continue
+		}
+		for _, polygon := range a.polygons {
+			if !inRing(polygon[0], lat, l) {
+				continue
+			}
+			inHole := false
+			for _, hole := range polygon[1:] {
+				if inRing(hole, lat, l) {
+					inHole = true
+					break
+				}
+			}
+			if !inHole {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Bounds returns the bounding box of the area with longitudes wrapped back
+// into [-180, 180]. west is greater than east when the box crosses the
+// antimeridian.
+func (a *area) Bounds() (south, west, north, east float64) {
+	if a.east-a.west >= 360 {
+		return a.south, -180, a.north, 180
+	}
+	return a.south, wrapLon(a.west), a.north, wrapLon(a.east)
+}
+
+// Center returns the center of the area's bounding box.
+func (a *area) Center() (lat, lon float64) {
+	return (a.south + a.north) / 2, wrapLon((a.west + a.east) / 2)
+}
+
+// inRing runs an even-odd ray casting test of lat/lon against ring.
+func inRing(ring [][2]float64, lat, lon float64) bool {
+	in := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		xi, yi := ring[i][0], ring[i][1]
+		xj, yj := ring[j][0], ring[j][1]
+		if (yi > lat) != (yj > lat) && lon < (xj-xi)*(lat-yi)/(yj-yi)+xi {
+			in = !in
+		}
+	}
+	return in
+}
+
+func wrapLon(lon float64) float64 {
+	for lon > 180 {
+		lon -= 360
+	}
+	for lon < -180 {
+		lon += 360
+	}
+	return lon
+}
+
+// loadNeighborhoods reads the optional GeoJSON FeatureCollection of named
+// neighborhood polygons, keyed by lower case name. A missing file leaves
+// the service without neighborhoods.
+func loadNeighborhoods(path string) map[string]*area {
+	file, err := data.Asset(path)
+	if err != nil {
+		return map[string]*area{}
+	}
+
+	neighborhoods, err := parseNeighborhoods(path, file)
+	if err != nil {
+		log.Fatalf("failed to load neighborhoods: %v", err)
+	}
+	return neighborhoods
+}
+
+// parseNeighborhoods reads the neighborhoods in file, rejecting unknown
+// fields, invalid geometries and names listed twice.
+func parseNeighborhoods(file string, b []byte) (map[string]*area, error) {
+	var fc struct {
+		Type     string          `json:"type"`
+		Features json.RawMessage `json:"features"`
+	}
+	if err := dataload.Decode(file, b, &fc); err != nil {
+		return nil, err
+	}
+	if fc.Type != "FeatureCollection" {
+		return nil, &dataload.Error{File: file, Index: -1, Field: "type", Msg: fmt.Sprintf("%q is not a FeatureCollection", fc.Type)}
+	}
+	if len(fc.Features) == 0 {
+		return map[string]*area{}, nil
+	}
+
+	type feature struct {
+		Type       string `json:"type"`
+		Properties struct {
+			Name string `json:"name"`
+		} `json:"properties"`
+		Geometry geometry `json:"geometry"`
+		area     *area
+	}
+	features, err := dataload.Records(file+".features", fc.Features, func(f *feature) error {
+		if f.Type != "Feature" {
+			return dataload.Invalid("type", "%q is not a Feature", f.Type)
+		}
+		if err := dataload.Required("properties.name", f.Properties.Name); err != nil {
+			return err
+		}
+		a, err := f.Geometry.area()
+		if err != nil {
+			return dataload.Invalid("geometry", "%v", err)
+		}
+		f.area = a
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	neighborhoods := make(map[string]*area, len(features))
+	seen := make(map[string]int, len(features))
+	for i, f := range features {
+		name := strings.ToLower(strings.TrimSpace(f.Properties.Name))
+		if prev, ok := seen[name]; ok {
+			return nil, &dataload.Error{File: file + ".features", Index: i, Field: "properties.name", Msg: fmt.Sprintf("neighborhood %q already listed at index %d", f.Properties.Name, prev)}
+		}
+		seen[name] = i
+		neighborhoods[name] = f.area
+	}
+	return neighborhoods, nil
+}
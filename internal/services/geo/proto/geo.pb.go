@@ -1,20 +1,17 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.26.0
-// 	protoc        v3.15.8
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
 // source: internal/services/geo/proto/geo.proto
 
 package proto
 
 import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -26,21 +23,39 @@ const (
 
 // The latitude and longitude of the current location.
 type Request struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: single precision location, read only when latitude and
+	// longitude are unset. Clients send both until every server reads the
+	// double fields.
+	//
+	// Deprecated: Marked as deprecated in internal/services/geo/proto/geo.proto.
 	Lat float32 `protobuf:"fixed32,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	// Deprecated: Marked as deprecated in internal/services/geo/proto/geo.proto.
 	Lon float32 `protobuf:"fixed32,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	// Search radius in kilometers. Zero uses the server default; values
+	// above the server maximum are rejected.
+	RadiusKm float32 `protobuf:"fixed32,3,opt,name=radiusKm,proto3" json:"radiusKm,omitempty"`
+	// Maximum number of hotels to return. Zero uses the server default;
+	// values above the server maximum are rejected.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Opaque cursor from a previous Result.nextCursor.
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Returns the limit closest hotels whatever their distance instead of
+	// the hotels within radiusKm. Nearest results come in a single page.
+	Nearest bool `protobuf:"varint,6,opt,name=nearest,proto3" json:"nearest,omitempty"`
+	// Double precision location. Set means it takes precedence over lat/lon,
+	// so the equator and the prime meridian stay expressible.
+	Latitude      *float64 `protobuf:"fixed64,7,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64 `protobuf:"fixed64,8,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Request) Reset() {
 	*x = Request{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_services_geo_proto_geo_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Request) String() string {
@@ -51,7 +66,7 @@ func (*Request) ProtoMessage() {}
 
 func (x *Request) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -66,6 +81,7 @@ func (*Request) Descriptor() ([]byte, []int) {
 	return file_internal_services_geo_proto_geo_proto_rawDescGZIP(), []int{0}
 }
 
+// Deprecated: Marked as deprecated in internal/services/geo/proto/geo.proto.
 func (x *Request) GetLat() float32 {
 	if x != nil {
 		return x.Lat
@@ -73,6 +89,7 @@ func (x *Request) GetLat() float32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in internal/services/geo/proto/geo.proto.
 func (x *Request) GetLon() float32 {
 	if x != nil {
 		return x.Lon
@@ -80,21 +97,268 @@ func (x *Request) GetLon() float32 {
 	return 0
 }
 
-type Result struct {
-	state         protoimpl.MessageState
+func (x *Request) GetRadiusKm() float32 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *Request) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Request) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *Request) GetNearest() bool {
+	if x != nil {
+		return x.Nearest
+	}
+	return false
+}
+
+func (x *Request) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *Request) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+// A viewport given by its north-east and south-west corners. Boxes whose
+// west edge lies east of their east edge cross the antimeridian.
+type BoundingBox struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	NorthEast *Location              `protobuf:"bytes,1,opt,name=northEast,proto3" json:"northEast,omitempty"`
+	SouthWest *Location              `protobuf:"bytes,2,opt,name=southWest,proto3" json:"southWest,omitempty"`
+	// Maximum number of hotels to return. Zero uses the server default;
+	// values above the server maximum are rejected.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Opaque cursor from a previous Result.nextCursor.
+	Cursor        string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoundingBox) Reset() {
+	*x = BoundingBox{}
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoundingBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoundingBox) ProtoMessage() {}
+
+func (x *BoundingBox) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoundingBox.ProtoReflect.Descriptor instead.
+func (*BoundingBox) Descriptor() ([]byte, []int) {
+	return file_internal_services_geo_proto_geo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BoundingBox) GetNorthEast() *Location {
+	if x != nil {
+		return x.NorthEast
+	}
+	return nil
+}
+
+func (x *BoundingBox) GetSouthWest() *Location {
+	if x != nil {
+		return x.SouthWest
+	}
+	return nil
+}
+
+func (x *BoundingBox) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// An area given either as a GeoJSON geometry or by neighborhood name.
+// Exactly one of the two must be set.
+type AreaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A GeoJSON Polygon or MultiPolygon geometry object, e.g.
+	// {"type":"Polygon","coordinates":[[[lon,lat],...]]}.
+	Geometry string `protobuf:"bytes,1,opt,name=geometry,proto3" json:"geometry,omitempty"`
+	// Name of a neighborhood known to the server, matched case-insensitively.
+	Neighborhood string `protobuf:"bytes,2,opt,name=neighborhood,proto3" json:"neighborhood,omitempty"`
+	// Maximum number of hotels to return. Zero uses the server default;
+	// values above the server maximum are rejected.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Opaque cursor from a previous Result.nextCursor.
+	Cursor        string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AreaRequest) Reset() {
+	*x = AreaRequest{}
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AreaRequest) ProtoMessage() {}
+
+func (x *AreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AreaRequest.ProtoReflect.Descriptor instead.
+func (*AreaRequest) Descriptor() ([]byte, []int) {
+	return file_internal_services_geo_proto_geo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AreaRequest) GetGeometry() string {
+	if x != nil {
+		return x.Geometry
+	}
+	return ""
+}
+
+func (x *AreaRequest) GetNeighborhood() string {
+	if x != nil {
+		return x.Neighborhood
+	}
+	return ""
+}
+
+func (x *AreaRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AreaRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon           float64                `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-	HotelIds []string `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Result) Reset() {
-	*x = Result{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_services_geo_proto_geo_proto_msgTypes[1]
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[3]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_internal_services_geo_proto_geo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Location) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *Location) GetLon() float64 {
+	if x != nil {
+		return x.Lon
 	}
+	return 0
+}
+
+type Result struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	HotelIds []string               `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
+	// Cursor for the next page, empty when there are no more hotels. Cursors
+	// are tied to the hotel locations they were handed out for: once
+	// locations change, a request with one fails with FailedPrecondition and
+	// paging must restart from the first page.
+	NextCursor string `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	// Locations of the hotels, in the same order as hotelIds.
+	Points        []*Point `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Result) Reset() {
+	*x = Result{}
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Result) String() string {
@@ -104,8 +368,8 @@ func (x *Result) String() string {
 func (*Result) ProtoMessage() {}
 
 func (x *Result) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[4]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -117,7 +381,7 @@ func (x *Result) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Result.ProtoReflect.Descriptor instead.
 func (*Result) Descriptor() ([]byte, []int) {
-	return file_internal_services_geo_proto_geo_proto_rawDescGZIP(), []int{1}
+	return file_internal_services_geo_proto_geo_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Result) GetHotelIds() []string {
@@ -127,181 +391,359 @@ func (x *Result) GetHotelIds() []string {
 	return nil
 }
 
-var File_internal_services_geo_proto_geo_proto protoreflect.FileDescriptor
+func (x *Result) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
 
-var file_internal_services_geo_proto_geo_proto_rawDesc = []byte{
-	0x0a, 0x25, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2f, 0x67, 0x65, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x67, 0x65, 0x6f, 0x22, 0x2d, 0x0a, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x6c, 0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x06, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x64,
-	0x73, 0x32, 0x2a, 0x0a, 0x03, 0x47, 0x65, 0x6f, 0x12, 0x23, 0x0a, 0x06, 0x4e, 0x65, 0x61, 0x72,
-	0x62, 0x79, 0x12, 0x0c, 0x2e, 0x67, 0x65, 0x6f, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0b, 0x2e, 0x67, 0x65, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x1f, 0x5a,
-	0x1d, 0x2e, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2f, 0x67, 0x65, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *Result) GetPoints() []*Point {
+	if x != nil {
+		return x.Points
+	}
+	return nil
 }
 
-var (
-	file_internal_services_geo_proto_geo_proto_rawDescOnce sync.Once
-	file_internal_services_geo_proto_geo_proto_rawDescData = file_internal_services_geo_proto_geo_proto_rawDesc
-)
+type UpsertRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Locations to store; distanceKm is ignored.
+	Points        []*Point `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-func file_internal_services_geo_proto_geo_proto_rawDescGZIP() []byte {
-	file_internal_services_geo_proto_geo_proto_rawDescOnce.Do(func() {
-		file_internal_services_geo_proto_geo_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_services_geo_proto_geo_proto_rawDescData)
-	})
-	return file_internal_services_geo_proto_geo_proto_rawDescData
+func (x *UpsertRequest) Reset() {
+	*x = UpsertRequest{}
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-var file_internal_services_geo_proto_geo_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_internal_services_geo_proto_geo_proto_goTypes = []interface{}{
-	(*Request)(nil), // 0: geo.Request
-	(*Result)(nil),  // 1: geo.Result
+func (x *UpsertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-var file_internal_services_geo_proto_geo_proto_depIdxs = []int32{
-	0, // 0: geo.Geo.Nearby:input_type -> geo.Request
-	1, // 1: geo.Geo.Nearby:output_type -> geo.Result
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (*UpsertRequest) ProtoMessage() {}
+
+func (x *UpsertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func init() { file_internal_services_geo_proto_geo_proto_init() }
-func file_internal_services_geo_proto_geo_proto_init() {
-	if File_internal_services_geo_proto_geo_proto != nil {
-		return
+// Deprecated: Use UpsertRequest.ProtoReflect.Descriptor instead.
+func (*UpsertRequest) Descriptor() ([]byte, []int) {
+	return file_internal_services_geo_proto_geo_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpsertRequest) GetPoints() []*Point {
+	if x != nil {
+		return x.Points
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_internal_services_geo_proto_geo_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Request); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_internal_services_geo_proto_geo_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Result); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+type DeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelIds      []string               `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_internal_services_geo_proto_geo_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_internal_services_geo_proto_geo_proto_goTypes,
-		DependencyIndexes: file_internal_services_geo_proto_geo_proto_depIdxs,
-		MessageInfos:      file_internal_services_geo_proto_geo_proto_msgTypes,
-	}.Build()
-	File_internal_services_geo_proto_geo_proto = out.File
-	file_internal_services_geo_proto_geo_proto_rawDesc = nil
-	file_internal_services_geo_proto_geo_proto_goTypes = nil
-	file_internal_services_geo_proto_geo_proto_depIdxs = nil
+	return mi.MessageOf(x)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_internal_services_geo_proto_geo_proto_rawDescGZIP(), []int{6}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func (x *DeleteRequest) GetHotelIds() []string {
+	if x != nil {
+		return x.HotelIds
+	}
+	return nil
+}
 
-// GeoClient is the client API for Geo service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GeoClient interface {
-	// Finds the hotels contained nearby the current lat/lon.
-	Nearby(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error)
+type UpdateResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of hotels added, moved or removed.
+	Updated int32 `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	// Number of hotels indexed after the update.
+	Total         int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type geoClient struct {
-	cc grpc.ClientConnInterface
+func (x *UpdateResult) Reset() {
+	*x = UpdateResult{}
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func NewGeoClient(cc grpc.ClientConnInterface) GeoClient {
-	return &geoClient{cc}
+func (x *UpdateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (c *geoClient) Nearby(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error) {
-	out := new(Result)
-	err := c.cc.Invoke(ctx, "/geo.Geo/Nearby", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (*UpdateResult) ProtoMessage() {}
+
+func (x *UpdateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResult.ProtoReflect.Descriptor instead.
+func (*UpdateResult) Descriptor() ([]byte, []int) {
+	return file_internal_services_geo_proto_geo_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateResult) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *UpdateResult) GetTotal() int32 {
+	if x != nil {
+		return x.Total
 	}
-	return out, nil
+	return 0
 }
 
-// GeoServer is the server API for Geo service.
-type GeoServer interface {
-	// Finds the hotels contained nearby the current lat/lon.
-	Nearby(context.Context, *Request) (*Result, error)
+type Point struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	HotelId string                 `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	Lat     float64                `protobuf:"fixed64,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon     float64                `protobuf:"fixed64,3,opt,name=lon,proto3" json:"lon,omitempty"`
+	// Distance from the requested location, or the center of the requested
+	// bounding box, in kilometers.
+	DistanceKm    float64 `protobuf:"fixed64,4,opt,name=distanceKm,proto3" json:"distanceKm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-// UnimplementedGeoServer can be embedded to have forward compatible implementations.
-type UnimplementedGeoServer struct {
+func (x *Point) Reset() {
+	*x = Point{}
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnimplementedGeoServer) Nearby(context.Context, *Request) (*Result, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Nearby not implemented")
+func (*Point) ProtoMessage() {}
+
+func (x *Point) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_geo_proto_geo_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func RegisterGeoServer(s *grpc.Server, srv GeoServer) {
-	s.RegisterService(&_Geo_serviceDesc, srv)
+// Deprecated: Use Point.ProtoReflect.Descriptor instead.
+func (*Point) Descriptor() ([]byte, []int) {
+	return file_internal_services_geo_proto_geo_proto_rawDescGZIP(), []int{8}
 }
 
-func _Geo_Nearby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
+func (x *Point) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
 	}
-	if interceptor == nil {
-		return srv.(GeoServer).Nearby(ctx, in)
+	return ""
+}
+
+func (x *Point) GetLat() float64 {
+	if x != nil {
+		return x.Lat
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/geo.Geo/Nearby",
+	return 0
+}
+
+func (x *Point) GetLon() float64 {
+	if x != nil {
+		return x.Lon
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GeoServer).Nearby(ctx, req.(*Request))
+	return 0
+}
+
+func (x *Point) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
+}
+
+var File_internal_services_geo_proto_geo_proto protoreflect.FileDescriptor
+
+const file_internal_services_geo_proto_geo_proto_rawDesc = "" +
+	"\n" +
+	"%internal/services/geo/proto/geo.proto\x12\x03geo\"\xf8\x01\n" +
+	"\aRequest\x12\x14\n" +
+	"\x03lat\x18\x01 \x01(\x02B\x02\x18\x01R\x03lat\x12\x14\n" +
+	"\x03lon\x18\x02 \x01(\x02B\x02\x18\x01R\x03lon\x12\x1a\n" +
+	"\bradiusKm\x18\x03 \x01(\x02R\bradiusKm\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x18\n" +
+	"\anearest\x18\x06 \x01(\bR\anearest\x12\x1f\n" +
+	"\blatitude\x18\a \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\b \x01(\x01H\x01R\tlongitude\x88\x01\x01B\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\x95\x01\n" +
+	"\vBoundingBox\x12+\n" +
+	"\tnorthEast\x18\x01 \x01(\v2\r.geo.LocationR\tnorthEast\x12+\n" +
+	"\tsouthWest\x18\x02 \x01(\v2\r.geo.LocationR\tsouthWest\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"{\n" +
+	"\vAreaRequest\x12\x1a\n" +
+	"\bgeometry\x18\x01 \x01(\tR\bgeometry\x12\"\n" +
+	"\fneighborhood\x18\x02 \x01(\tR\fneighborhood\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\".\n" +
+	"\bLocation\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x02 \x01(\x01R\x03lon\"h\n" +
+	"\x06Result\x12\x1a\n" +
+	"\bhotelIds\x18\x01 \x03(\tR\bhotelIds\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\"\n" +
+	"\x06points\x18\x03 \x03(\v2\n" +
+	".geo.PointR\x06points\"3\n" +
+	"\rUpsertRequest\x12\"\n" +
+	"\x06points\x18\x01 \x03(\v2\n" +
+	".geo.PointR\x06points\"+\n" +
+	"\rDeleteRequest\x12\x1a\n" +
+	"\bhotelIds\x18\x01 \x03(\tR\bhotelIds\">\n" +
+	"\fUpdateResult\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x05R\aupdated\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"e\n" +
+	"\x05Point\x12\x18\n" +
+	"\ahotelId\x18\x01 \x01(\tR\ahotelId\x12\x10\n" +
+	"\x03lat\x18\x02 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x03 \x01(\x01R\x03lon\x12\x1e\n" +
+	"\n" +
+	"distanceKm\x18\x04 \x01(\x01R\n" +
+	"distanceKm2\xea\x01\n" +
+	"\x03Geo\x12#\n" +
+	"\x06Nearby\x12\f.geo.Request\x1a\v.geo.Result\x12'\n" +
+	"\x06Within\x12\x10.geo.BoundingBox\x1a\v.geo.Result\x12'\n" +
+	"\x06InArea\x12\x10.geo.AreaRequest\x1a\v.geo.Result\x125\n" +
+	"\fUpsertPoints\x12\x12.geo.UpsertRequest\x1a\x11.geo.UpdateResult\x125\n" +
+	"\fDeletePoints\x12\x12.geo.DeleteRequest\x1a\x11.geo.UpdateResultBAZ?github.com/harlow/go-micro-services/internal/services/geo/protob\x06proto3"
+
+var (
+	file_internal_services_geo_proto_geo_proto_rawDescOnce sync.Once
+	file_internal_services_geo_proto_geo_proto_rawDescData []byte
+)
+
+func file_internal_services_geo_proto_geo_proto_rawDescGZIP() []byte {
+	file_internal_services_geo_proto_geo_proto_rawDescOnce.Do(func() {
+		file_internal_services_geo_proto_geo_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_services_geo_proto_geo_proto_rawDesc), len(file_internal_services_geo_proto_geo_proto_rawDesc)))
+	})
+	return file_internal_services_geo_proto_geo_proto_rawDescData
+}
+
+var file_internal_services_geo_proto_geo_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_internal_services_geo_proto_geo_proto_goTypes = []any{
+	(*Request)(nil),       // 0: geo.Request
+	(*BoundingBox)(nil),   // 1: geo.BoundingBox
+	(*AreaRequest)(nil),   // 2: geo.AreaRequest
+	(*Location)(nil),      // 3: geo.Location
+	(*Result)(nil),        // 4: geo.Result
+	(*UpsertRequest)(nil), // 5: geo.UpsertRequest
+	(*DeleteRequest)(nil), // 6: geo.DeleteRequest
+	(*UpdateResult)(nil),  // 7: geo.UpdateResult
+	(*Point)(nil),         // 8: geo.Point
+}
+var file_internal_services_geo_proto_geo_proto_depIdxs = []int32{
+	3, // 0: geo.BoundingBox.northEast:type_name -> geo.Location
+	3, // 1: geo.BoundingBox.southWest:type_name -> geo.Location
+	8, // 2: geo.Result.points:type_name -> geo.Point
+	8, // 3: geo.UpsertRequest.points:type_name -> geo.Point
+	0, // 4: geo.Geo.Nearby:input_type -> geo.Request
+	1, // 5: geo.Geo.Within:input_type -> geo.BoundingBox
+	2, // 6: geo.Geo.InArea:input_type -> geo.AreaRequest
+	5, // 7: geo.Geo.UpsertPoints:input_type -> geo.UpsertRequest
+	6, // 8: geo.Geo.DeletePoints:input_type -> geo.DeleteRequest
+	4, // 9: geo.Geo.Nearby:output_type -> geo.Result
+	4, // 10: geo.Geo.Within:output_type -> geo.Result
+	4, // 11: geo.Geo.InArea:output_type -> geo.Result
+	7, // 12: geo.Geo.UpsertPoints:output_type -> geo.UpdateResult
+	7, // 13: geo.Geo.DeletePoints:output_type -> geo.UpdateResult
+	9, // [9:14] is the sub-list for method output_type
+	4, // [4:9] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
-var _Geo_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "geo.Geo",
-	HandlerType: (*GeoServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Nearby",
-			Handler:    _Geo_Nearby_Handler,
+func init() { file_internal_services_geo_proto_geo_proto_init() }
+func file_internal_services_geo_proto_geo_proto_init() {
+	if File_internal_services_geo_proto_geo_proto != nil {
+		return
+	}
+	file_internal_services_geo_proto_geo_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_services_geo_proto_geo_proto_rawDesc), len(file_internal_services_geo_proto_geo_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
 		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "internal/services/geo/proto/geo.proto",
+		GoTypes:           file_internal_services_geo_proto_geo_proto_goTypes,
+		DependencyIndexes: file_internal_services_geo_proto_geo_proto_depIdxs,
+		MessageInfos:      file_internal_services_geo_proto_geo_proto_msgTypes,
+	}.Build()
+	File_internal_services_geo_proto_geo_proto = out.File
+	file_internal_services_geo_proto_geo_proto_goTypes = nil
+	file_internal_services_geo_proto_geo_proto_depIdxs = nil
 }
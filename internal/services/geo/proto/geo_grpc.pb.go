@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             v3.21.12
+// source: internal/services/geo/proto/geo.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Geo_Nearby_FullMethodName       = "/geo.Geo/Nearby"
+	Geo_Within_FullMethodName       = "/geo.Geo/Within"
+	Geo_InArea_FullMethodName       = "/geo.Geo/InArea"
+	Geo_UpsertPoints_FullMethodName = "/geo.Geo/UpsertPoints"
+	Geo_DeletePoints_FullMethodName = "/geo.Geo/DeletePoints"
+)
+
+// GeoClient is the client API for Geo service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GeoClient interface {
+	// Finds the hotels contained nearby the current lat/lon.
+	Nearby(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error)
+	// Finds the hotels inside a map viewport, nearest its center first.
+	Within(ctx context.Context, in *BoundingBox, opts ...grpc.CallOption) (*Result, error)
+	// Finds the hotels inside a polygon or named neighborhood, nearest the
+	// center of its bounding box first.
+	InArea(ctx context.Context, in *AreaRequest, opts ...grpc.CallOption) (*Result, error)
+	// Admin: adds hotel locations or moves existing ones.
+	UpsertPoints(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*UpdateResult, error)
+	// Admin: removes hotel locations.
+	DeletePoints(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*UpdateResult, error)
+}
+
+type geoClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGeoClient(cc grpc.ClientConnInterface) GeoClient {
+	return &geoClient{cc}
+}
+
+func (c *geoClient) Nearby(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Result)
+	err := c.cc.Invoke(ctx, Geo_Nearby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoClient) Within(ctx context.Context, in *BoundingBox, opts ...grpc.CallOption) (*Result, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Result)
+	err := c.cc.Invoke(ctx, Geo_Within_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoClient) InArea(ctx context.Context, in *AreaRequest, opts ...grpc.CallOption) (*Result, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Result)
+	err := c.cc.Invoke(ctx, Geo_InArea_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoClient) UpsertPoints(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*UpdateResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateResult)
+	err := c.cc.Invoke(ctx, Geo_UpsertPoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoClient) DeletePoints(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*UpdateResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateResult)
+	err := c.cc.Invoke(ctx, Geo_DeletePoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GeoServer is the server API for Geo service.
+// All implementations must embed UnimplementedGeoServer
+// for forward compatibility.
+type GeoServer interface {
+	// Finds the hotels contained nearby the current lat/lon.
+	Nearby(context.Context, *Request) (*Result, error)
+	// Finds the hotels inside a map viewport, nearest its center first.
+	Within(context.Context, *BoundingBox) (*Result, error)
+	// Finds the hotels inside a polygon or named neighborhood, nearest the
+	// center of its bounding box first.
+	InArea(context.Context, *AreaRequest) (*Result, error)
+	// Admin: adds hotel locations or moves existing ones.
+	UpsertPoints(context.Context, *UpsertRequest) (*UpdateResult, error)
+	// Admin: removes hotel locations.
+	DeletePoints(context.Context, *DeleteRequest) (*UpdateResult, error)
+	mustEmbedUnimplementedGeoServer()
+}
+
+// UnimplementedGeoServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGeoServer struct{}
+
+func (UnimplementedGeoServer) Nearby(context.Context, *Request) (*Result, error) {
+	return nil, status.Error(codes.Unimplemented, "method Nearby not implemented")
+}
+func (UnimplementedGeoServer) Within(context.Context, *BoundingBox) (*Result, error) {
+	return nil, status.Error(codes.Unimplemented, "method Within not implemented")
+}
+func (UnimplementedGeoServer) InArea(context.Context, *AreaRequest) (*Result, error) {
+	return nil, status.Error(codes.Unimplemented, "method InArea not implemented")
+}
+func (UnimplementedGeoServer) UpsertPoints(context.Context, *UpsertRequest) (*UpdateResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpsertPoints not implemented")
+}
+func (UnimplementedGeoServer) DeletePoints(context.Context, *DeleteRequest) (*UpdateResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePoints not implemented")
+}
+func (UnimplementedGeoServer) mustEmbedUnimplementedGeoServer() {}
+func (UnimplementedGeoServer) testEmbeddedByValue()             {}
+
+// UnsafeGeoServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GeoServer will
+// result in compilation errors.
+type UnsafeGeoServer interface {
+	mustEmbedUnimplementedGeoServer()
+}
+
+func RegisterGeoServer(s grpc.ServiceRegistrar, srv GeoServer) {
+	// If the following call panics, it indicates UnimplementedGeoServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Geo_ServiceDesc, srv)
+}
+
+func _Geo_Nearby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoServer).Nearby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Geo_Nearby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoServer).Nearby(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Geo_Within_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoundingBox)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoServer).Within(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Geo_Within_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoServer).Within(ctx, req.(*BoundingBox))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Geo_InArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AreaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoServer).InArea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Geo_InArea_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoServer).InArea(ctx, req.(*AreaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Geo_UpsertPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoServer).UpsertPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Geo_UpsertPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoServer).UpsertPoints(ctx, req.(*UpsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Geo_DeletePoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoServer).DeletePoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Geo_DeletePoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoServer).DeletePoints(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Geo_ServiceDesc is the grpc.ServiceDesc for Geo service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Geo_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "geo.Geo",
+	HandlerType: (*GeoServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Nearby",
+			Handler:    _Geo_Nearby_Handler,
+		},
+		{
+			MethodName: "Within",
+			Handler:    _Geo_Within_Handler,
+		},
+		{
+			MethodName: "InArea",
+			Handler:    _Geo_InArea_Handler,
+		},
+		{
+			MethodName: "UpsertPoints",
+			Handler:    _Geo_UpsertPoints_Handler,
+		},
+		{
+			MethodName: "DeletePoints",
+			Handler:    _Geo_DeletePoints_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/services/geo/proto/geo.proto",
+}
@@ -0,0 +1,87 @@
+package profile
+
+import (
+	"fmt"
+	"strings"
+
+	profile "github.com/harlow/go-micro-services/internal/services/profile/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// maskTree is a field mask as a tree of field names. Fields mapped to nil
+// are kept whole, the others only keep the fields of their subtree.
+type maskTree map[protoreflect.Name]maskTree
+
+// newMaskTree checks the paths of mask against Hotel and returns them as a
+// tree, or nil when the mask is empty and hotels are returned whole.
+func newMaskTree(mask *fieldmaskpb.FieldMask) (maskTree, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil
+	}
+
+	hotel := (&profile.Hotel{}).ProtoReflect().Descriptor()
+	tree := maskTree{"id": nil}
+	for _, path := range mask.GetPaths() {
+		md, node := hotel, tree
+		names := strings.Split(path, ".")
+		for i, name := range names {
+			fd := md.Fields().ByName(protoreflect.Name(name))
+			if fd == nil {
+				return nil, fmt.Errorf("%q is not a field of %s", path, md.Name())
+			}
+			if i == len(names)-1 {
+				node[fd.Name()] = nil
+				break
+			}
+			if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+				return nil, fmt.Errorf("%q has no subfields", strings.Join(names[:i+1], "."))
+			}
+
+			child, ok := node[fd.Name()]
+			if ok && child == nil {
+				// the parent is already kept whole
+				break
+			}
+			if !ok {
+				child = maskTree{}
+				node[fd.Name()] = child
+			}
+			md, node = fd.Message(), child
+		}
+	}
+	return tree, nil
+}
+
+// apply returns a copy of h with only the fields in the tree set. h itself
+// is shared and left untouched.
+func (t maskTree) apply(h *profile.Hotel) *profile.Hotel {
+	if t == nil {
+		return h
+	}
+	pruned := proto.Clone(h).(*profile.Hotel)
+	t.prune(pruned.ProtoReflect())
+	return pruned
+}
+
+func (t maskTree) prune(m protoreflect.Message) {
+	var drop, partial []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		sub, ok := t[fd.Name()]
+		switch {
+		case !ok:
+			drop = append(drop, fd)
+		case sub != nil:
+			partial = append(partial, fd)
+		}
+		return true
+	})
+
+	for _, fd := range drop {
+		m.Clear(fd)
+	}
+	for _, fd := range partial {
+		t[fd.Name()].prune(m.Mutable(fd).Message())
+	}
+}
@@ -0,0 +1,128 @@
+package profile
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/harlow/go-micro-services/data"
+	"github.com/harlow/go-micro-services/internal/dataload"
+	profile "github.com/harlow/go-micro-services/internal/services/profile/proto"
+	"golang.org/x/text/language"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultLocale is the locale of the names and descriptions in hotels.json,
+// served when no translation matches.
+const defaultLocale = "en"
+
+// translationRecord is how a hotel's name and description are stored for a
+// locale in translations.json. Either may be left out to keep the one in
+// hotels.json.
+type translationRecord struct {
+	HotelID     string `json:"hotelId"`
+	Locale      string `json:"locale"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// loadTranslations loads the translated profiles of each hotel from a JSON
+// file.
+func loadTranslations(path string, profiles map[string]*profile.Hotel) map[string]map[string]*profile.Hotel {
+	translations, err := parseTranslations(path, data.MustAsset(path), profiles)
+	if err != nil {
+		log.Fatalf("failed to load translations: %v", err)
+	}
+	return translations
+}
+
+// parseTranslations reads the translation records in file and returns the
+// profiles they translate, keyed by hotel id and then by canonical locale.
+func parseTranslations(file string, b []byte, profiles map[string]*profile.Hotel) (map[string]map[string]*profile.Hotel, error) {
+	records, err := dataload.Records(file, b, checkTranslation)
+	if err != nil {
+		return nil, err
+	}
+
+	translations := make(map[string]map[string]*profile.Hotel)
+	for i, r := range records {
+		base, ok := profiles[r.HotelID]
+		if !ok {
+			return nil, &dataload.Error{File: file, Index: i, Field: "hotelId", Msg: fmt.Sprintf("hotel %s is not in the profiles", r.HotelID)}
+		}
+		// checkTranslation has already parsed the locale
+		locale := canonicalLocale(language.MustParse(r.Locale))
+		if locale == defaultLocale {
+			return nil, &dataload.Error{File: file, Index: i, Field: "locale", Msg: fmt.Sprintf("%s is the locale of the profiles themselves", locale)}
+		}
+		if _, ok := translations[r.HotelID][locale]; ok {
+			return nil, &dataload.Error{File: file, Index: i, Msg: fmt.Sprintf("hotel %s is already translated to %s", r.HotelID, locale)}
+		}
+
+		h := proto.Clone(base).(*profile.Hotel)
+		if r.Name != "" {
+			h.Name = r.Name
+		}
+		if r.Description != "" {
+			h.Description = r.Description
+		}
+		h.Locale = locale
+		if translations[r.HotelID] == nil {
+			translations[r.HotelID] = make(map[string]*profile.Hotel)
+		}
+		translations[r.HotelID][locale] = h
+	}
+	return translations, nil
+}
+
+// checkTranslation validates the values of a translation record.
+func checkTranslation(r *translationRecord) error {
+	if err := dataload.Required("hotelId", r.HotelID); err != nil {
+		return err
+	}
+	if _, err := language.Parse(r.Locale); err != nil {
+		return dataload.Invalid("locale", "%q is not a BCP 47 language tag", r.Locale)
+	}
+	if strings.TrimSpace(r.Name) == "" && strings.TrimSpace(r.Description) == "" {
+		return dataload.Invalid("", "needs a name or a description")
+	}
+	return nil
+}
+
+// fallbacks returns the locales to look up for a requested locale, most
+// specific first: the locale itself, its parents made by dropping subtags
+// from the end and finally defaultLocale, e.g. fr-CA, fr, en.
+func fallbacks(locale string) ([]string, error) {
+	if strings.TrimSpace(locale) == "" {
+		return []string{defaultLocale}, nil
+	}
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []string
+	for l := canonicalLocale(tag); l != "und"; {
+		out = append(out, l)
+		i := strings.LastIndex(l, "-")
+		if i < 0 {
+			break
+		}
+		l = l[:i]
+	}
+	if len(out) == 0 || out[len(out)-1] != defaultLocale {
+		out = append(out, defaultLocale)
+	}
+	return out, nil
+}
+
+// canonicalLocale returns the language, script and region of tag in
+// canonical form, dropping variants and extensions.
+func canonicalLocale(tag language.Tag) string {
+	base, script, region := tag.Raw()
+	t, err := language.Compose(base, script, region)
+	if err != nil {
+		return tag.String()
+	}
+	return t.String()
+}
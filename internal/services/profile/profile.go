@@ -1,12 +1,17 @@
 package profile
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 	"net"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/harlow/go-micro-services/data"
+	"github.com/harlow/go-micro-services/internal/amenities"
+	"github.com/harlow/go-micro-services/internal/dataload"
+	"github.com/harlow/go-micro-services/internal/rpcerr"
 	runtime "github.com/harlow/go-micro-services/internal/runtime"
 	profile "github.com/harlow/go-micro-services/internal/services/profile/proto"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -16,20 +21,26 @@ import (
 
 // New returns a new server
 func New() *Profile {
+	profiles := loadProfiles("data/hotels.json")
 	return &Profile{
-		profiles: loadProfiles("data/hotels.json"),
+		profiles:     profiles,
+		translations: loadTranslations("data/translations.json", profiles),
 	}
 }
 
 // Profile implements the profile service
 type Profile struct {
+	profile.UnimplementedProfileServer
 	profiles map[string]*profile.Hotel
+	// translated profiles by hotel id and locale
+	translations map[string]map[string]*profile.Hotel
 }
 
 // Run starts the server
 func (s *Profile) Run(port int) error {
 	srv := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(runtime.RejectWhileDraining),
 	)
 	profile.RegisterProfileServer(srv, s)
 
@@ -41,37 +52,208 @@ func (s *Profile) Run(port int) error {
 	return runtime.ServeGRPCGracefully(lis, srv)
 }
 
-// GetProfiles returns hotel profiles for requested IDs
+// GetProfiles returns hotel profiles for requested IDs, translated to the
+// requested locale or the closest one each hotel has and pruned to the
+// requested fields.
 func (s *Profile) GetProfiles(ctx context.Context, req *profile.Request) (*profile.Result, error) {
+	locales, err := fallbacks(req.Locale)
+	if err != nil {
+		return nil, rpcerr.InvalidArgument("locale", fmt.Sprintf("%q is not a BCP 47 language tag", req.Locale))
+	}
+	mask, err := newMaskTree(req.FieldMask)
+	if err != nil {
+		return nil, rpcerr.InvalidArgument("fieldMask", err.Error())
+	}
+
 	res := new(profile.Result)
 	for _, id := range req.HotelIds {
-		h := s.getProfile(id)
+		h := s.getProfile(id, locales...)
 		if h == nil {
 			continue
 		}
-		res.Hotels = append(res.Hotels, h)
+		res.Hotels = append(res.Hotels, mask.apply(h))
 	}
 	return res, nil
 }
 
-func (s *Profile) getProfile(id string) *profile.Hotel {
+// getProfile returns the profile of a hotel in the first of locales it is
+// translated to, or untranslated.
+func (s *Profile) getProfile(id string, locales ...string) *profile.Hotel {
+	for _, l := range locales {
+		if h, ok := s.translations[id][l]; ok {
+			return h
+		}
+	}
 	return s.profiles[id]
 }
 
+// FindByCity returns IDs of hotels whose address matches the city query
+func (s *Profile) FindByCity(ctx context.Context, req *profile.CityRequest) (*profile.CityResult, error) {
+	if strings.TrimSpace(req.City) == "" {
+		return nil, rpcerr.InvalidArgument("city", "must not be empty")
+	}
+
+	res := new(profile.CityResult)
+	for id, h := range s.profiles {
+		if matchesCity(h.Address, req.City) {
+			res.HotelIds = append(res.HotelIds, id)
+		}
+	}
+	if len(res.HotelIds) == 0 {
+		return nil, rpcerr.NotFound("city", req.City, fmt.Sprintf("no hotels found in %q", req.City))
+	}
+
+	sort.Strings(res.HotelIds)
+	return res, nil
+}
+
+// matchesCity reports whether addr matches a query of the form
+// "city[, state][, country]". Comparison is case-insensitive and any
+// qualifier after the city must equal the address state or country.
+func matchesCity(addr *profile.Address, query string) bool {
+	if addr == nil {
+		return false
+	}
+
+	parts := strings.Split(query, ",")
+	city := strings.TrimSpace(parts[0])
+	if city == "" || !strings.EqualFold(city, strings.TrimSpace(addr.City)) {
+		return false
+	}
+
+	for _, part := range parts[1:] {
+		part = strings.TrimSpace(part)
+		if !strings.EqualFold(part, strings.TrimSpace(addr.State)) &&
+			!strings.EqualFold(part, strings.TrimSpace(addr.Country)) {
+			return false
+		}
+	}
+	return true
+}
+
 // loadProfiles loads hotel profiles from a JSON file.
 func loadProfiles(path string) map[string]*profile.Hotel {
-	var (
-		file   = data.MustAsset(path)
-		hotels []*profile.Hotel
-	)
+	profiles, err := parseProfiles(path, data.MustAsset(path))
+	if err != nil {
+		log.Fatalf("failed to load profiles: %v", err)
+	}
+	return profiles
+}
+
+// timeFmt is the layout of check-in and check-out times.
+const timeFmt = "15:04"
+
+// hotelRecord is how a profile is stored in hotels.json. Coordinates are
+// read at full precision, which the deprecated proto floats would lose.
+type hotelRecord struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	PhoneNumber string            `json:"phoneNumber"`
+	Description string            `json:"description"`
+	Address     *addressRecord    `json:"address"`
+	Images      []*profile.Image  `json:"images"`
+	Amenities   []string          `json:"amenities"`
+	Policies    *profile.Policies `json:"policies"`
+}
+
+type addressRecord struct {
+	StreetNumber string  `json:"streetNumber"`
+	StreetName   string  `json:"streetName"`
+	City         string  `json:"city"`
+	State        string  `json:"state"`
+	Country      string  `json:"country"`
+	PostalCode   string  `json:"postalCode"`
+	Lat          float64 `json:"lat"`
+	Lon          float64 `json:"lon"`
+}
+
+// parseProfiles reads the hotel profiles in file, keyed by hotel id.
+func parseProfiles(file string, b []byte) (map[string]*profile.Hotel, error) {
+	records, err := dataload.Records(file, b, checkHotel)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make(map[string]*profile.Hotel, len(records))
+	for i, r := range records {
+		if _, ok := profiles[r.ID]; ok {
+			return nil, &dataload.Error{File: file, Index: i, Field: "id", Msg: fmt.Sprintf("hotel %s is listed twice", r.ID)}
+		}
 
-	if err := json.Unmarshal(file, &hotels); err != nil {
-		log.Fatalf("Failed to load json: %v", err)
+		a := r.Address
+		lat, lon := a.Lat, a.Lon
+		profiles[r.ID] = &profile.Hotel{
+			Id:          r.ID,
+			Name:        r.Name,
+			PhoneNumber: r.PhoneNumber,
+			Description: r.Description,
+			Address: &profile.Address{
+				StreetNumber: a.StreetNumber,
+				StreetName:   a.StreetName,
+				City:         a.City,
+				State:        a.State,
+				Country:      a.Country,
+				PostalCode:   a.PostalCode,
+				Lat:          float32(lat),
+				Lon:          float32(lon),
+				Latitude:     &lat,
+				Longitude:    &lon,
+			},
+			Images:    r.Images,
+			Locale:    defaultLocale,
+			Amenities: r.Amenities,
+			Policies:  r.Policies,
+		}
 	}
+	return profiles, nil
+}
 
-	profiles := make(map[string]*profile.Hotel)
-	for _, hotel := range hotels {
-		profiles[hotel.Id] = hotel
+// checkHotel validates the values of a profile record.
+func checkHotel(r *hotelRecord) error {
+	if err := dataload.Required("id", r.ID); err != nil {
+		return err
 	}
-	return profiles
+	if err := dataload.Required("name", r.Name); err != nil {
+		return err
+	}
+	if r.Address == nil {
+		return dataload.Invalid("address", "is required")
+	}
+	if err := dataload.Required("address.city", r.Address.City); err != nil {
+		return err
+	}
+	if err := dataload.Range("address.lat", r.Address.Lat, -90, 90); err != nil {
+		return err
+	}
+	if err := dataload.Range("address.lon", r.Address.Lon, -180, 180); err != nil {
+		return err
+	}
+	for j, img := range r.Images {
+		if img == nil || strings.TrimSpace(img.Url) == "" {
+			return dataload.Invalid(fmt.Sprintf("images[%d].url", j), "is required")
+		}
+	}
+	seen := make(map[string]bool, len(r.Amenities))
+	for j, a := range r.Amenities {
+		field := fmt.Sprintf("amenities[%d]", j)
+		if !amenities.Known(a) {
+			return dataload.Invalid(field, "%q is not a known amenity", a)
+		}
+		if seen[a] {
+			return dataload.Invalid(field, "%q is listed twice", a)
+		}
+		seen[a] = true
+	}
+	if p := r.Policies; p != nil {
+		if _, err := time.Parse(timeFmt, p.CheckIn); err != nil {
+			return dataload.Invalid("policies.checkIn", "expected HH:MM, got %q", p.CheckIn)
+		}
+		if _, err := time.Parse(timeFmt, p.CheckOut); err != nil {
+			return dataload.Invalid("policies.checkOut", "expected HH:MM, got %q", p.CheckOut)
+		}
+		if p.FreeCancellationHours != nil && p.GetFreeCancellationHours() < 0 {
+			return dataload.Invalid("policies.freeCancellationHours", "must not be negative")
+		}
+	}
+	return nil
 }
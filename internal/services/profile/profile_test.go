@@ -1,8 +1,16 @@
 package profile
 
 import (
+	"reflect"
 	"testing"
 
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/harlow/go-micro-services/data"
 	profile "github.com/harlow/go-micro-services/internal/services/profile/proto"
 )
 
@@ -19,3 +27,213 @@ func TestGetProfile(t *testing.T) {
 		t.Fatalf("get profile error: expected %v, got %v", "Cliff Hotel", got.Name)
 	}
 }
+
+func TestFindByCity(t *testing.T) {
+	s := &Profile{
+		profiles: map[string]*profile.Hotel{
+			"1": {Id: "1", Address: &profile.Address{City: "San Francisco", State: "CA", Country: "United States"}},
+			"2": {Id: "2", Address: &profile.Address{City: "Portland", State: "OR", Country: "United States"}},
+			"3": {Id: "3", Address: &profile.Address{City: "Portland", State: "ME", Country: "United States"}},
+		},
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+		code  codes.Code
+	}{
+		{"portland", []string{"2", "3"}, codes.OK},
+		{"Portland, OR", []string{"2"}, codes.OK},
+		{"San Francisco, CA, United States", []string{"1"}, codes.OK},
+		{"San Francisco, NY", nil, codes.NotFound},
+		{"", nil, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		res, err := s.FindByCity(context.Background(), &profile.CityRequest{City: tt.query})
+		if status.Code(err) != tt.code {
+			t.Fatalf("FindByCity(%q) error = %v, want code %v", tt.query, err, tt.code)
+		}
+		if !reflect.DeepEqual(res.GetHotelIds(), tt.want) {
+			t.Fatalf("FindByCity(%q) = %v, want %v", tt.query, res.GetHotelIds(), tt.want)
+		}
+	}
+}
+
+func TestLoadProfilesKeepsDoublePrecision(t *testing.T) {
+	addr := loadProfiles("data/hotels.json")["7"].Address
+
+	if addr.GetLatitude() != 37.79242 || addr.GetLongitude() != -122.4097691 {
+		t.Fatalf("location = (%v, %v), want (37.79242, -122.4097691)", addr.GetLatitude(), addr.GetLongitude())
+	}
+	if addr.Lat != float32(37.79242) {
+		t.Fatalf("legacy lat = %v, want %v", addr.Lat, float32(37.79242))
+	}
+}
+
+func TestLoadProfilesReadsAmenitiesAndPolicies(t *testing.T) {
+	profiles := loadProfiles("data/hotels.json")
+
+	h := profiles["1"]
+	if !reflect.DeepEqual(h.Amenities, []string{"wifi", "gym", "restaurant", "pets"}) {
+		t.Fatalf("amenities = %v", h.Amenities)
+	}
+	if p := h.Policies; p.CheckIn != "15:00" || p.CheckOut != "12:00" || p.GetFreeCancellationHours() != 48 {
+		t.Fatalf("policies = %v", p)
+	}
+	if p := profiles["5"].Policies; p.FreeCancellationHours != nil {
+		t.Fatalf("expected hotel 5 to be non-refundable, got %v", p)
+	}
+}
+
+func TestParseProfilesValidatesRecords(t *testing.T) {
+	for _, tt := range []struct {
+		in, want string
+	}{
+		{`[{"id": "1", "name": "Clift", "address": {"city": "San Francisco", "lat": 37.78, "lon": -122.41, "zip": "94102"}}]`, "hotels.json[0].address.zip: unknown field"},
+		{`[{"id": "1", "name": "Clift", "address": {"city": "San Francisco", "lat": 37.78, "lon": -222.41}}]`, "hotels.json[0].address.lon: -222.41 is out of range [-180, 180]"},
+		{`[{"id": "1", "name": "Clift"}]`, "hotels.json[0].address: is required"},
+		{`[{"id": "1", "name": "Clift", "address": {"city": "San Francisco"}, "images": [{"default": true}]}]`, "hotels.json[0].images[0].url: is required"},
+		{`[{"id": "1", "name": "Clift", "address": {"city": "San Francisco"}, "amenities": ["wifi", "sauna"]}]`, `hotels.json[0].amenities[1]: "sauna" is not a known amenity`},
+		{`[{"id": "1", "name": "Clift", "address": {"city": "San Francisco"}, "amenities": ["pool", "pool"]}]`, `hotels.json[0].amenities[1]: "pool" is listed twice`},
+		{`[{"id": "1", "name": "Clift", "address": {"city": "San Francisco"}, "policies": {"checkIn": "3pm", "checkOut": "12:00"}}]`, `hotels.json[0].policies.checkIn: expected HH:MM, got "3pm"`},
+	} {
+		_, err := parseProfiles("hotels.json", []byte(tt.in))
+		if err == nil || err.Error() != tt.want {
+			t.Fatalf("%s: error = %v, want %q", tt.in, err, tt.want)
+		}
+	}
+}
+
+func TestFallbacks(t *testing.T) {
+	for _, tt := range []struct {
+		locale string
+		want   []string
+	}{
+		{"", []string{"en"}},
+		{"en", []string{"en"}},
+		{"fr-ca", []string{"fr-CA", "fr", "en"}},
+		{"zh-Hant-TW", []string{"zh-Hant-TW", "zh-Hant", "zh", "en"}},
+		{"de-CH-u-ca-gregory", []string{"de-CH", "de", "en"}},
+		{"en-GB", []string{"en-GB", "en"}},
+	} {
+		got, err := fallbacks(tt.locale)
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("fallbacks(%q) = %v, %v, want %v", tt.locale, got, err, tt.want)
+		}
+	}
+	if _, err := fallbacks("not a locale"); err == nil {
+		t.Fatal("expected an invalid tag to be rejected")
+	}
+}
+
+func TestGetProfilesTranslates(t *testing.T) {
+	profiles := map[string]*profile.Hotel{
+		"1": {Id: "1", Name: "Clift Hotel", Description: "Luxury hotel", Locale: "en"},
+		"2": {Id: "2", Name: "W San Francisco", Description: "Trendy hotel", Locale: "en"},
+	}
+	translations, err := parseTranslations("translations.json", []byte(`[
+		{"hotelId": "1", "locale": "fr", "description": "Hôtel de luxe"},
+		{"hotelId": "1", "locale": "fr-ca", "name": "Hôtel Clift"}
+	]`), profiles)
+	if err != nil {
+		t.Fatalf("parseTranslations returned error: %v", err)
+	}
+	s := &Profile{profiles: profiles, translations: translations}
+
+	for _, tt := range []struct {
+		locale, id, name, description, served string
+	}{
+		{"fr-CA", "1", "Hôtel Clift", "Luxury hotel", "fr-CA"},
+		{"fr-BE", "1", "Clift Hotel", "Hôtel de luxe", "fr"},
+		{"fr", "2", "W San Francisco", "Trendy hotel", "en"},
+		{"", "1", "Clift Hotel", "Luxury hotel", "en"},
+	} {
+		res, err := s.GetProfiles(context.Background(), &profile.Request{HotelIds: []string{tt.id}, Locale: tt.locale})
+		if err != nil {
+			t.Fatalf("%s: GetProfiles returned error: %v", tt.locale, err)
+		}
+		h := res.Hotels[0]
+		if h.Name != tt.name || h.Description != tt.description || h.Locale != tt.served {
+			t.Fatalf("%s: got %q, %q in %q, want %q, %q in %q", tt.locale, h.Name, h.Description, h.Locale, tt.name, tt.description, tt.served)
+		}
+	}
+
+	_, err = s.GetProfiles(context.Background(), &profile.Request{HotelIds: []string{"1"}, Locale: "français"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for a bad locale, got %v", err)
+	}
+}
+
+func TestParseTranslationsValidatesRecords(t *testing.T) {
+	profiles := loadProfiles("data/hotels.json")
+	if _, err := parseTranslations("data/translations.json", data.MustAsset("data/translations.json"), profiles); err != nil {
+		t.Fatalf("embedded translations do not parse: %v", err)
+	}
+
+	for _, tt := range []struct {
+		in, want string
+	}{
+		{`[{"hotelId": "99", "locale": "fr", "name": "Inconnu"}]`, "translations.json[0].hotelId: hotel 99 is not in the profiles"},
+		{`[{"hotelId": "1", "locale": "french", "name": "Clift"}]`, `translations.json[0].locale: "french" is not a BCP 47 language tag`},
+		{`[{"hotelId": "1", "locale": "EN", "name": "Clift"}]`, "translations.json[0].locale: en is the locale of the profiles themselves"},
+		{`[{"hotelId": "1", "locale": "fr"}]`, "translations.json[0]: needs a name or a description"},
+		{`[{"hotelId": "1", "locale": "fr", "name": "A"}, {"hotelId": "1", "locale": "FR", "name": "B"}]`, "translations.json[1]: hotel 1 is already translated to fr"},
+	} {
+		_, err := parseTranslations("translations.json", []byte(tt.in), profiles)
+		if err == nil || err.Error() != tt.want {
+			t.Fatalf("%s: error = %v, want %q", tt.in, err, tt.want)
+		}
+	}
+}
+
+func TestGetProfilesPrunesToFieldMask(t *testing.T) {
+	lat, lon := 37.7867, -122.4112
+	s := &Profile{profiles: map[string]*profile.Hotel{
+		"1": {
+			Id:          "1",
+			Name:        "Clift Hotel",
+			Description: "Luxury hotel",
+			Address:     &profile.Address{City: "San Francisco", State: "CA", Latitude: &lat, Longitude: &lon},
+			Images:      []*profile.Image{{Url: "clift.jpg", Default: true}},
+		},
+	}}
+
+	res, err := s.GetProfiles(context.Background(), &profile.Request{
+		HotelIds:  []string{"1"},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "address.latitude", "address.longitude"}},
+	})
+	if err != nil {
+		t.Fatalf("GetProfiles returned error: %v", err)
+	}
+	h := res.Hotels[0]
+	want := &profile.Hotel{Id: "1", Name: "Clift Hotel", Address: &profile.Address{Latitude: &lat, Longitude: &lon}}
+	if !proto.Equal(h, want) {
+		t.Fatalf("got %v, want %v", h, want)
+	}
+	if s.profiles["1"].Description != "Luxury hotel" || s.profiles["1"].Address.City != "San Francisco" {
+		t.Fatalf("pruning changed the stored profile: %v", s.profiles["1"])
+	}
+
+	// a whole message wins over its subfields
+	res, err = s.GetProfiles(context.Background(), &profile.Request{
+		HotelIds:  []string{"1"},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"address.city", "address"}},
+	})
+	if err != nil {
+		t.Fatalf("GetProfiles returned error: %v", err)
+	}
+	if got := res.Hotels[0].Address; got.State != "CA" || got.GetLatitude() != lat {
+		t.Fatalf("expected the whole address, got %v", got)
+	}
+
+	for _, path := range []string{"rating", "address.zip", "images.url", "name.first"} {
+		_, err := s.GetProfiles(context.Background(), &profile.Request{
+			HotelIds:  []string{"1"},
+			FieldMask: &fieldmaskpb.FieldMask{Paths: []string{path}},
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%s: expected InvalidArgument, got %v", path, err)
+		}
+	}
+}
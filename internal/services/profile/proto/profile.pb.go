@@ -1,20 +1,18 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.26.0
-// 	protoc        v3.15.8
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
 // source: internal/services/profile/proto/profile.proto
 
 package proto
 
 import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -25,21 +23,23 @@ const (
 )
 
 type Request struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	HotelIds []string               `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
+	// BCP 47 language tag, e.g. "fr-CA". Hotels without a translation for it
+	// fall back to its parent locales and then to "en". Empty means "en".
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Fields of Hotel to return, e.g. "name" or "address.city". The id is
+	// always returned. Empty means every field.
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=fieldMask,proto3" json:"fieldMask,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	HotelIds []string `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
-	Locale   string   `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Request) Reset() {
 	*x = Request{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_services_profile_proto_profile_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Request) String() string {
@@ -50,7 +50,7 @@ func (*Request) ProtoMessage() {}
 
 func (x *Request) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -79,21 +79,25 @@ func (x *Request) GetLocale() string {
 	return ""
 }
 
+func (x *Request) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
 type Result struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hotels        []*Hotel               `protobuf:"bytes,1,rep,name=hotels,proto3" json:"hotels,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Hotels []*Hotel `protobuf:"bytes,1,rep,name=hotels,proto3" json:"hotels,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Result) Reset() {
 	*x = Result{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_services_profile_proto_profile_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Result) String() string {
@@ -104,7 +108,7 @@ func (*Result) ProtoMessage() {}
 
 func (x *Result) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -126,26 +130,118 @@ func (x *Result) GetHotels() []*Hotel {
 	return nil
 }
 
-type Hotel struct {
-	state         protoimpl.MessageState
+type CityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CityRequest) Reset() {
+	*x = CityRequest{}
+	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CityRequest) ProtoMessage() {}
+
+func (x *CityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CityRequest.ProtoReflect.Descriptor instead.
+func (*CityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_services_profile_proto_profile_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CityRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type CityResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelIds      []string               `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	PhoneNumber string   `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
-	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Address     *Address `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	Images      []*Image `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`
+func (x *CityResult) Reset() {
+	*x = CityResult{}
+	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Hotel) Reset() {
-	*x = Hotel{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_services_profile_proto_profile_proto_msgTypes[2]
+func (x *CityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CityResult) ProtoMessage() {}
+
+func (x *CityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[3]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CityResult.ProtoReflect.Descriptor instead.
+func (*CityResult) Descriptor() ([]byte, []int) {
+	return file_internal_services_profile_proto_profile_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CityResult) GetHotelIds() []string {
+	if x != nil {
+		return x.HotelIds
 	}
+	return nil
+}
+
+type Hotel struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PhoneNumber string                 `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Address     *Address               `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Images      []*Image               `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`
+	// Locale name and description are in, which may be a fallback of the
+	// requested one.
+	Locale string `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Amenities the hotel offers: "wifi", "parking", "pets", "pool", "gym",
+	// "spa", "restaurant" or "breakfast".
+	Amenities     []string  `protobuf:"bytes,8,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	Policies      *Policies `protobuf:"bytes,9,opt,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hotel) Reset() {
+	*x = Hotel{}
+	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Hotel) String() string {
@@ -155,8 +251,8 @@ func (x *Hotel) String() string {
 func (*Hotel) ProtoMessage() {}
 
 func (x *Hotel) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[4]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -168,7 +264,7 @@ func (x *Hotel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Hotel.ProtoReflect.Descriptor instead.
 func (*Hotel) Descriptor() ([]byte, []int) {
-	return file_internal_services_profile_proto_profile_proto_rawDescGZIP(), []int{2}
+	return file_internal_services_profile_proto_profile_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Hotel) GetId() string {
@@ -213,28 +309,116 @@ func (x *Hotel) GetImages() []*Image {
 	return nil
 }
 
+func (x *Hotel) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Hotel) GetAmenities() []string {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+func (x *Hotel) GetPolicies() *Policies {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type Policies struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Earliest check-in and latest check-out, as local HH:MM times.
+	CheckIn  string `protobuf:"bytes,1,opt,name=checkIn,proto3" json:"checkIn,omitempty"`
+	CheckOut string `protobuf:"bytes,2,opt,name=checkOut,proto3" json:"checkOut,omitempty"`
+	// Bookings can be cancelled free of charge until this many hours before
+	// check-in. Unset means bookings are non-refundable.
+	FreeCancellationHours *int32 `protobuf:"varint,3,opt,name=freeCancellationHours,proto3,oneof" json:"freeCancellationHours,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Policies) Reset() {
+	*x = Policies{}
+	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Policies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Policies) ProtoMessage() {}
+
+func (x *Policies) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Policies.ProtoReflect.Descriptor instead.
+func (*Policies) Descriptor() ([]byte, []int) {
+	return file_internal_services_profile_proto_profile_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Policies) GetCheckIn() string {
+	if x != nil {
+		return x.CheckIn
+	}
+	return ""
+}
+
+func (x *Policies) GetCheckOut() string {
+	if x != nil {
+		return x.CheckOut
+	}
+	return ""
+}
+
+func (x *Policies) GetFreeCancellationHours() int32 {
+	if x != nil && x.FreeCancellationHours != nil {
+		return *x.FreeCancellationHours
+	}
+	return 0
+}
+
 type Address struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	StreetNumber string                 `protobuf:"bytes,1,opt,name=streetNumber,proto3" json:"streetNumber,omitempty"`
+	StreetName   string                 `protobuf:"bytes,2,opt,name=streetName,proto3" json:"streetName,omitempty"`
+	City         string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	State        string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Country      string                 `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	PostalCode   string                 `protobuf:"bytes,6,opt,name=postalCode,proto3" json:"postalCode,omitempty"`
+	// Deprecated: single precision location. Servers fill both it and
+	// latitude/longitude; clients should read the double fields.
+	//
+	// Deprecated: Marked as deprecated in internal/services/profile/proto/profile.proto.
+	Lat float32 `protobuf:"fixed32,7,opt,name=lat,proto3" json:"lat,omitempty"`
+	// Deprecated: Marked as deprecated in internal/services/profile/proto/profile.proto.
+	Lon           float32  `protobuf:"fixed32,8,opt,name=lon,proto3" json:"lon,omitempty"`
+	Latitude      *float64 `protobuf:"fixed64,9,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64 `protobuf:"fixed64,10,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	StreetNumber string  `protobuf:"bytes,1,opt,name=streetNumber,proto3" json:"streetNumber,omitempty"`
-	StreetName   string  `protobuf:"bytes,2,opt,name=streetName,proto3" json:"streetName,omitempty"`
-	City         string  `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	State        string  `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	Country      string  `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
-	PostalCode   string  `protobuf:"bytes,6,opt,name=postalCode,proto3" json:"postalCode,omitempty"`
-	Lat          float32 `protobuf:"fixed32,7,opt,name=lat,proto3" json:"lat,omitempty"`
-	Lon          float32 `protobuf:"fixed32,8,opt,name=lon,proto3" json:"lon,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Address) Reset() {
 	*x = Address{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_services_profile_proto_profile_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Address) String() string {
@@ -244,8 +428,8 @@ func (x *Address) String() string {
 func (*Address) ProtoMessage() {}
 
 func (x *Address) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[6]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -257,7 +441,7 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Address.ProtoReflect.Descriptor instead.
 func (*Address) Descriptor() ([]byte, []int) {
-	return file_internal_services_profile_proto_profile_proto_rawDescGZIP(), []int{3}
+	return file_internal_services_profile_proto_profile_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Address) GetStreetNumber() string {
@@ -302,6 +486,7 @@ func (x *Address) GetPostalCode() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in internal/services/profile/proto/profile.proto.
 func (x *Address) GetLat() float32 {
 	if x != nil {
 		return x.Lat
@@ -309,6 +494,7 @@ func (x *Address) GetLat() float32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in internal/services/profile/proto/profile.proto.
 func (x *Address) GetLon() float32 {
 	if x != nil {
 		return x.Lon
@@ -316,22 +502,33 @@ func (x *Address) GetLon() float32 {
 	return 0
 }
 
+func (x *Address) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *Address) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
 type Image struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Default       bool                   `protobuf:"varint,2,opt,name=default,proto3" json:"default,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Url     string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Default bool   `protobuf:"varint,2,opt,name=default,proto3" json:"default,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Image) Reset() {
 	*x = Image{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_services_profile_proto_profile_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Image) String() string {
@@ -341,8 +538,8 @@ func (x *Image) String() string {
 func (*Image) ProtoMessage() {}
 
 func (x *Image) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_internal_services_profile_proto_profile_proto_msgTypes[7]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -354,7 +551,7 @@ func (x *Image) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Image.ProtoReflect.Descriptor instead.
 func (*Image) Descriptor() ([]byte, []int) {
-	return file_internal_services_profile_proto_profile_proto_rawDescGZIP(), []int{4}
+	return file_internal_services_profile_proto_profile_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Image) GetUrl() string {
@@ -373,87 +570,101 @@ func (x *Image) GetDefault() bool {
 
 var File_internal_services_profile_proto_profile_proto protoreflect.FileDescriptor
 
-var file_internal_services_profile_proto_profile_proto_rawDesc = []byte{
-	0x0a, 0x2d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x3d, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x30, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x26, 0x0a, 0x06, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x48, 0x6f, 0x74, 0x65,
-	0x6c, 0x52, 0x06, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x05, 0x48, 0x6f,
-	0x74, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x22,
-	0xd5, 0x01, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73,
-	0x74, 0x72, 0x65, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
-	0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x03, 0x6c, 0x6f, 0x6e, 0x22, 0x33, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x32, 0x3b, 0x0a, 0x07,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x23, 0x5a, 0x21, 0x2e, 0x2f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
+const file_internal_services_profile_proto_profile_proto_rawDesc = "" +
+	"\n" +
+	"-internal/services/profile/proto/profile.proto\x12\aprofile\x1a google/protobuf/field_mask.proto\"w\n" +
+	"\aRequest\x12\x1a\n" +
+	"\bhotelIds\x18\x01 \x03(\tR\bhotelIds\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x128\n" +
+	"\tfieldMask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"0\n" +
+	"\x06Result\x12&\n" +
+	"\x06hotels\x18\x01 \x03(\v2\x0e.profile.HotelR\x06hotels\"!\n" +
+	"\vCityRequest\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\"(\n" +
+	"\n" +
+	"CityResult\x12\x1a\n" +
+	"\bhotelIds\x18\x01 \x03(\tR\bhotelIds\"\xa8\x02\n" +
+	"\x05Hotel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vphoneNumber\x18\x03 \x01(\tR\vphoneNumber\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12*\n" +
+	"\aaddress\x18\x05 \x01(\v2\x10.profile.AddressR\aaddress\x12&\n" +
+	"\x06images\x18\x06 \x03(\v2\x0e.profile.ImageR\x06images\x12\x16\n" +
+	"\x06locale\x18\a \x01(\tR\x06locale\x12\x1c\n" +
+	"\tamenities\x18\b \x03(\tR\tamenities\x12-\n" +
+	"\bpolicies\x18\t \x01(\v2\x11.profile.PoliciesR\bpolicies\"\x95\x01\n" +
+	"\bPolicies\x12\x18\n" +
+	"\acheckIn\x18\x01 \x01(\tR\acheckIn\x12\x1a\n" +
+	"\bcheckOut\x18\x02 \x01(\tR\bcheckOut\x129\n" +
+	"\x15freeCancellationHours\x18\x03 \x01(\x05H\x00R\x15freeCancellationHours\x88\x01\x01B\x18\n" +
+	"\x16_freeCancellationHours\"\xbc\x02\n" +
+	"\aAddress\x12\"\n" +
+	"\fstreetNumber\x18\x01 \x01(\tR\fstreetNumber\x12\x1e\n" +
+	"\n" +
+	"streetName\x18\x02 \x01(\tR\n" +
+	"streetName\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x18\n" +
+	"\acountry\x18\x05 \x01(\tR\acountry\x12\x1e\n" +
+	"\n" +
+	"postalCode\x18\x06 \x01(\tR\n" +
+	"postalCode\x12\x14\n" +
+	"\x03lat\x18\a \x01(\x02B\x02\x18\x01R\x03lat\x12\x14\n" +
+	"\x03lon\x18\b \x01(\x02B\x02\x18\x01R\x03lon\x12\x1f\n" +
+	"\blatitude\x18\t \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\n" +
+	" \x01(\x01H\x01R\tlongitude\x88\x01\x01B\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"3\n" +
+	"\x05Image\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x18\n" +
+	"\adefault\x18\x02 \x01(\bR\adefault2t\n" +
+	"\aProfile\x120\n" +
+	"\vGetProfiles\x12\x10.profile.Request\x1a\x0f.profile.Result\x127\n" +
+	"\n" +
+	"FindByCity\x12\x14.profile.CityRequest\x1a\x13.profile.CityResultB#Z!./internal/services/profile/protob\x06proto3"
 
 var (
 	file_internal_services_profile_proto_profile_proto_rawDescOnce sync.Once
-	file_internal_services_profile_proto_profile_proto_rawDescData = file_internal_services_profile_proto_profile_proto_rawDesc
+	file_internal_services_profile_proto_profile_proto_rawDescData []byte
 )
 
 func file_internal_services_profile_proto_profile_proto_rawDescGZIP() []byte {
 	file_internal_services_profile_proto_profile_proto_rawDescOnce.Do(func() {
-		file_internal_services_profile_proto_profile_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_services_profile_proto_profile_proto_rawDescData)
+		file_internal_services_profile_proto_profile_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_services_profile_proto_profile_proto_rawDesc), len(file_internal_services_profile_proto_profile_proto_rawDesc)))
 	})
 	return file_internal_services_profile_proto_profile_proto_rawDescData
 }
 
-var file_internal_services_profile_proto_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
-var file_internal_services_profile_proto_profile_proto_goTypes = []interface{}{
-	(*Request)(nil), // 0: profile.Request
-	(*Result)(nil),  // 1: profile.Result
-	(*Hotel)(nil),   // 2: profile.Hotel
-	(*Address)(nil), // 3: profile.Address
-	(*Image)(nil),   // 4: profile.Image
+var file_internal_services_profile_proto_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_internal_services_profile_proto_profile_proto_goTypes = []any{
+	(*Request)(nil),               // 0: profile.Request
+	(*Result)(nil),                // 1: profile.Result
+	(*CityRequest)(nil),           // 2: profile.CityRequest
+	(*CityResult)(nil),            // 3: profile.CityResult
+	(*Hotel)(nil),                 // 4: profile.Hotel
+	(*Policies)(nil),              // 5: profile.Policies
+	(*Address)(nil),               // 6: profile.Address
+	(*Image)(nil),                 // 7: profile.Image
+	(*fieldmaskpb.FieldMask)(nil), // 8: google.protobuf.FieldMask
 }
 var file_internal_services_profile_proto_profile_proto_depIdxs = []int32{
-	2, // 0: profile.Result.hotels:type_name -> profile.Hotel
-	3, // 1: profile.Hotel.address:type_name -> profile.Address
-	4, // 2: profile.Hotel.images:type_name -> profile.Image
-	0, // 3: profile.Profile.GetProfiles:input_type -> profile.Request
-	1, // 4: profile.Profile.GetProfiles:output_type -> profile.Result
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8, // 0: profile.Request.fieldMask:type_name -> google.protobuf.FieldMask
+	4, // 1: profile.Result.hotels:type_name -> profile.Hotel
+	6, // 2: profile.Hotel.address:type_name -> profile.Address
+	7, // 3: profile.Hotel.images:type_name -> profile.Image
+	5, // 4: profile.Hotel.policies:type_name -> profile.Policies
+	0, // 5: profile.Profile.GetProfiles:input_type -> profile.Request
+	2, // 6: profile.Profile.FindByCity:input_type -> profile.CityRequest
+	1, // 7: profile.Profile.GetProfiles:output_type -> profile.Result
+	3, // 8: profile.Profile.FindByCity:output_type -> profile.CityResult
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_internal_services_profile_proto_profile_proto_init() }
@@ -461,75 +672,15 @@ func file_internal_services_profile_proto_profile_proto_init() {
 	if File_internal_services_profile_proto_profile_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_internal_services_profile_proto_profile_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Request); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_internal_services_profile_proto_profile_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Result); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_internal_services_profile_proto_profile_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Hotel); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_internal_services_profile_proto_profile_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Address); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_internal_services_profile_proto_profile_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Image); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
+	file_internal_services_profile_proto_profile_proto_msgTypes[5].OneofWrappers = []any{}
+	file_internal_services_profile_proto_profile_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_internal_services_profile_proto_profile_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_services_profile_proto_profile_proto_rawDesc), len(file_internal_services_profile_proto_profile_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -538,87 +689,6 @@ func file_internal_services_profile_proto_profile_proto_init() {
 		MessageInfos:      file_internal_services_profile_proto_profile_proto_msgTypes,
 	}.Build()
 	File_internal_services_profile_proto_profile_proto = out.File
-	file_internal_services_profile_proto_profile_proto_rawDesc = nil
 	file_internal_services_profile_proto_profile_proto_goTypes = nil
 	file_internal_services_profile_proto_profile_proto_depIdxs = nil
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
-
-// ProfileClient is the client API for Profile service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ProfileClient interface {
-	GetProfiles(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error)
-}
-
-type profileClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewProfileClient(cc grpc.ClientConnInterface) ProfileClient {
-	return &profileClient{cc}
-}
-
-func (c *profileClient) GetProfiles(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error) {
-	out := new(Result)
-	err := c.cc.Invoke(ctx, "/profile.Profile/GetProfiles", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// ProfileServer is the server API for Profile service.
-type ProfileServer interface {
-	GetProfiles(context.Context, *Request) (*Result, error)
-}
-
-// UnimplementedProfileServer can be embedded to have forward compatible implementations.
-type UnimplementedProfileServer struct {
-}
-
-func (*UnimplementedProfileServer) GetProfiles(context.Context, *Request) (*Result, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProfiles not implemented")
-}
-
-func RegisterProfileServer(s *grpc.Server, srv ProfileServer) {
-	s.RegisterService(&_Profile_serviceDesc, srv)
-}
-
-func _Profile_GetProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProfileServer).GetProfiles(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/profile.Profile/GetProfiles",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).GetProfiles(ctx, req.(*Request))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Profile_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "profile.Profile",
-	HandlerType: (*ProfileServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetProfiles",
-			Handler:    _Profile_GetProfiles_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "internal/services/profile/proto/profile.proto",
-}
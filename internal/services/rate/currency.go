@@ -0,0 +1,195 @@
+package rate
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/harlow/go-micro-services/data"
+	"github.com/harlow/go-micro-services/internal/dataload"
+	rate "github.com/harlow/go-micro-services/internal/services/rate/proto"
+)
+
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// exchangeRates is a table of currencies, each with its rate against a
+// common base currency and the number of decimals its amounts carry.
+type exchangeRates map[string]currency
+
+type currency struct {
+	// units of the currency per unit of the base currency
+	rate       *big.Rat
+	minorUnits int
+}
+
+// exchangeRecord is how a currency is stored in exchange_rates.json.
+type exchangeRecord struct {
+	Currency   string  `json:"currency"`
+	Rate       float64 `json:"rate"`
+	MinorUnits int     `json:"minorUnits"`
+}
+
+// loadExchangeRates reads the exchange rate table from path on disk, or
+// from the embedded data/exchange_rates.json when path is empty.
+func loadExchangeRates(path string) (exchangeRates, error) {
+	if path == "" {
+		path = "data/exchange_rates.json"
+		return parseExchangeRates(path, data.MustAsset(path))
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseExchangeRates(path, b)
+}
+
+func parseExchangeRates(file string, b []byte) (exchangeRates, error) {
+	records, err := dataload.Records(file, b, func(r *exchangeRecord) error {
+		if !currencyCode.MatchString(r.Currency) {
+			return dataload.Invalid("currency", "%q is not an ISO 4217 code", r.Currency)
+		}
+		if r.Rate <= 0 {
+			return dataload.Invalid("rate", "%v must be positive", r.Rate)
+		}
+		if r.MinorUnits < 0 || r.MinorUnits > 4 {
+			return dataload.Invalid("minorUnits", "%d is out of range [0, 4]", r.MinorUnits)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make(exchangeRates, len(records))
+	for i, r := range records {
+		if _, ok := rates[r.Currency]; ok {
+			return nil, &dataload.Error{File: file, Index: i, Field: "currency", Msg: fmt.Sprintf("%s is listed twice", r.Currency)}
+		}
+		rates[r.Currency] = currency{rate: decimal(r.Rate), minorUnits: r.MinorUnits}
+	}
+	return rates, nil
+}
+
+// covers checks that x has a rate for every currency the inventory is
+// priced in, without which plans could not be converted.
+func (x exchangeRates) covers(inventory map[string][]*planInventory) error {
+	missing := make(map[string]bool)
+	for _, plans := range inventory {
+		for _, plan := range plans {
+			if _, ok := x[plan.RoomType.Currency]; !ok {
+				missing[plan.RoomType.Currency] = true
+			}
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	codes := make([]string, 0, len(missing))
+	for c := range missing {
+		codes = append(codes, c)
+	}
+	sort.Strings(codes)
+	return fmt.Errorf("no exchange rate for %s, which the inventory is priced in", strings.Join(codes, ", "))
+}
+
+// convert returns the plan's prices in the target currency, keeping the
+// prices it was quoted in as Original. Amounts are converted from their
+// decimal value and rounded half away from zero to the target's minor
+// units. The inclusive total is the sum of the converted amounts, so the
+// charges still add up to it.
+func (x exchangeRates) convert(plan *rate.RatePlan, target string) (*rate.RatePlan, error) {
+	rt := plan.RoomType
+	from, ok := x[rt.Currency]
+	if !ok {
+		return nil, fmt.Errorf("no exchange rate for %s", rt.Currency)
+	}
+	to, ok := x[target]
+	if !ok {
+		return nil, fmt.Errorf("no exchange rate for %s", target)
+	}
+	if rt.Currency == target {
+		return plan, nil
+	}
+
+	factor := new(big.Rat).Quo(to.rate, from.rate)
+	conv := func(amount float64) float64 {
+		return roundDecimal(new(big.Rat).Mul(decimal(amount), factor), to.minorUnits)
+	}
+
+	charges := make([]*rate.Charge, 0, len(rt.Charges))
+	total := conv(rt.TotalRate)
+	inclusive := decimal(total)
+	for _, c := range rt.Charges {
+		amount := conv(c.Amount)
+		inclusive.Add(inclusive, decimal(amount))
+		charges = append(charges, &rate.Charge{
+			Code:        c.Code,
+			Description: c.Description,
+			Type:        c.Type,
+			Amount:      amount,
+		})
+	}
+	if len(rt.Charges) == 0 {
+		// plans without itemized charges only know their inclusive total
+		inclusive = decimal(conv(rt.TotalRateInclusive))
+	}
+
+	out := &rate.RatePlan{
+		HotelId: plan.HotelId,
+		Code:    plan.Code,
+		InDate:  plan.InDate,
+		OutDate: plan.OutDate,
+		RoomType: &rate.RoomType{
+			BookableRate:       conv(rt.BookableRate),
+			TotalRate:          total,
+			TotalRateInclusive: roundDecimal(inclusive, to.minorUnits),
+			Code:               rt.Code,
+			Currency:           target,
+			RoomDescription:    rt.RoomDescription,
+			AverageNightlyRate: conv(rt.AverageNightlyRate),
+			Nights:             rt.Nights,
+			Charges:            charges,
+			RoomsLeft:          rt.RoomsLeft,
+			Original: &rate.Price{
+				Currency:           rt.Currency,
+				BookableRate:       rt.BookableRate,
+				TotalRate:          rt.TotalRate,
+				TotalRateInclusive: rt.TotalRateInclusive,
+				AverageNightlyRate: rt.AverageNightlyRate,
+			},
+		},
+	}
+	out.RoomType.ExchangeRate, _ = factor.Float64()
+	return out, nil
+}
+
+// decimal returns the exact decimal value v was written as, rather than
+// its binary approximation, so 1.005 rounds to 1.01 and not 1.00.
+func decimal(v float64) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(v, 'g', -1, 64))
+	return r
+}
+
+// roundDecimal rounds r half away from zero to the given number of
+// decimal places.
+func roundDecimal(r *big.Rat, places int) float64 {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(scale))
+
+	// |scaled| + 1/2, truncated
+	half := big.NewRat(1, 2)
+	n := new(big.Rat).Add(new(big.Rat).Abs(scaled), half)
+	q := new(big.Int).Quo(n.Num(), n.Denom())
+	if scaled.Sign() < 0 {
+		q.Neg(q)
+	}
+
+	f, _ := new(big.Rat).SetFrac(q, scale).Float64()
+	return f
+}
@@ -0,0 +1,217 @@
+package rate
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/harlow/go-micro-services/internal/dataload"
+	rate "github.com/harlow/go-micro-services/internal/services/rate/proto"
+)
+
+const dateFmt = "2006-01-02"
+
+// Reasons a plan cannot be booked for a stay.
+const (
+	reasonUnavailable       = "UNAVAILABLE"
+	reasonMinNights         = "MIN_NIGHTS"
+	reasonMaxNights         = "MAX_NIGHTS"
+	reasonClosedToArrival   = "CLOSED_TO_ARRIVAL"
+	reasonClosedToDeparture = "CLOSED_TO_DEPARTURE"
+	reasonSoldOut           = "SOLD_OUT"
+)
+
+// planInventory is one rate plan of a hotel with every night it can be
+// booked, keyed by the night's date.
+type planInventory struct {
+	HotelID  string
+	Code     string
+	RoomType roomType
+	Nights   map[string]night
+}
+
+// night is the rate of a single night before taxes and the restrictions
+// on stays arriving or departing on it.
+type night struct {
+	Rate float64 `json:"rate"`
+	// Length of stay limits for stays arriving on the night, zero when
+	// unrestricted.
+	MinNights         int  `json:"minNights"`
+	MaxNights         int  `json:"maxNights"`
+	ClosedToArrival   bool `json:"closedToArrival"`
+	ClosedToDeparture bool `json:"closedToDeparture"`
+}
+
+type roomType struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	Currency    string `json:"currency"`
+}
+
+// inventoryRecord is how a rate plan is stored in inventory.json: its
+// nights given as periods of nights sharing a price and restrictions.
+type inventoryRecord struct {
+	HotelID  string   `json:"hotelId"`
+	Code     string   `json:"code"`
+	RoomType roomType `json:"roomType"`
+	Nights   []struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+		night
+	} `json:"nights"`
+}
+
+// parseInventory reads inventory records from file and expands their
+// periods into nightly rates, grouped by hotel. Periods run from the night
+// of From up to, but not including, the night of To, and must not overlap.
+// A hotel may have any
+// number of plans, but only one per rate code and room type.
+func parseInventory(file string, b []byte) (map[string][]*planInventory, error) {
+	records, err := dataload.Records(file, b, checkRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := make(map[string][]*planInventory)
+	seen := make(map[[3]string]int)
+	for i, r := range records {
+		key := [3]string{r.HotelID, r.Code, r.RoomType.Code}
+		if prev, ok := seen[key]; ok {
+			return nil, &dataload.Error{
+				File:  file,
+				Index: i,
+				Msg:   fmt.Sprintf("hotel %s already has plan %s for room type %s at index %d", r.HotelID, r.Code, r.RoomType.Code, prev),
+			}
+		}
+		seen[key] = i
+
+		plan := &planInventory{
+			HotelID:  r.HotelID,
+			Code:     r.Code,
+			RoomType: r.RoomType,
+			Nights:   make(map[string]night),
+		}
+		pricedBy := make(map[string]int)
+		for j, period := range r.Nights {
+			// checkRecord has already parsed the dates
+			from, _ := time.Parse(dateFmt, period.From)
+			to, _ := time.Parse(dateFmt, period.To)
+			for night := from; night.Before(to); night = night.AddDate(0, 0, 1) {
+				date := night.Format(dateFmt)
+				if prev, ok := pricedBy[date]; ok {
+					return nil, &dataload.Error{File: file, Index: i, Field: fmt.Sprintf("nights[%d]", j), Msg: fmt.Sprintf("%s is already priced by nights[%d]", date, prev)}
+				}
+				pricedBy[date] = j
+				plan.Nights[date] = period.night
+			}
+		}
+		inventory[r.HotelID] = append(inventory[r.HotelID], plan)
+	}
+	return inventory, nil
+}
+
+// checkRecord validates the values of an inventory record.
+func checkRecord(r *inventoryRecord) error {
+	if err := dataload.Required("hotelId", r.HotelID); err != nil {
+		return err
+	}
+	if err := dataload.Required("code", r.Code); err != nil {
+		return err
+	}
+	if err := dataload.Required("roomType.code", r.RoomType.Code); err != nil {
+		return err
+	}
+	if !currencyCode.MatchString(r.RoomType.Currency) {
+		return dataload.Invalid("roomType.currency", "%q is not an ISO 4217 code", r.RoomType.Currency)
+	}
+
+	for j, period := range r.Nights {
+		field := fmt.Sprintf("nights[%d]", j)
+		from, err := time.Parse(dateFmt, period.From)
+		if err != nil {
+			return dataload.Invalid(field+".from", "%q is not a YYYY-MM-DD date", period.From)
+		}
+		to, err := time.Parse(dateFmt, period.To)
+		if err != nil {
+			return dataload.Invalid(field+".to", "%q is not a YYYY-MM-DD date", period.To)
+		}
+		if !to.After(from) {
+			return dataload.Invalid(field+".to", "%s must be after from", period.To)
+		}
+		if period.Rate <= 0 {
+			return dataload.Invalid(field+".rate", "%v must be positive", period.Rate)
+		}
+		if period.MinNights < 0 {
+			return dataload.Invalid(field+".minNights", "%d must not be negative", period.MinNights)
+		}
+		if period.MaxNights < 0 || (period.MaxNights > 0 && period.MaxNights < period.MinNights) {
+			return dataload.Invalid(field+".maxNights", "%d must not be below minNights", period.MaxNights)
+		}
+	}
+	return nil
+}
+
+// quote prices a stay on the plan, from the night of in up to the night
+// before out, adding the hotel's charges on top of the room rate. When the
+// stay cannot be booked on the plan it returns the reason instead.
+func (p *planInventory) quote(in, out time.Time, rules []chargeRule) (*rate.RatePlan, *rate.Exclusion) {
+	var total float64
+	nights := 0
+	for d := in; d.Before(out); d = d.AddDate(0, 0, 1) {
+		n, ok := p.Nights[d.Format(dateFmt)]
+		if !ok {
+			return nil, p.exclusion(reasonUnavailable, "not available on %s", d.Format(dateFmt))
+		}
+		total += n.Rate
+		nights++
+	}
+
+	arrival := p.Nights[in.Format(dateFmt)]
+	switch {
+	case arrival.ClosedToArrival:
+		return nil, p.exclusion(reasonClosedToArrival, "no arrivals on %s", in.Format(dateFmt))
+	case arrival.MinNights > 0 && nights < arrival.MinNights:
+		return nil, p.exclusion(reasonMinNights, "minimum stay %d nights", arrival.MinNights)
+	case arrival.MaxNights > 0 && nights > arrival.MaxNights:
+		return nil, p.exclusion(reasonMaxNights, "maximum stay %d nights", arrival.MaxNights)
+	}
+	// the departure day need not be a night of the stay, nor for sale
+	if p.Nights[out.Format(dateFmt)].ClosedToDeparture {
+		return nil, p.exclusion(reasonClosedToDeparture, "no departures on %s", out.Format(dateFmt))
+	}
+
+	total = roundCents(total)
+	charges, inclusive := applyCharges(rules, total, nights)
+	average := roundCents(total / float64(nights))
+	return &rate.RatePlan{
+		HotelId: p.HotelID,
+		Code:    p.Code,
+		InDate:  in.Format(dateFmt),
+		OutDate: out.Format(dateFmt),
+		RoomType: &rate.RoomType{
+			BookableRate:       average,
+			TotalRate:          total,
+			TotalRateInclusive: inclusive,
+			Code:               p.RoomType.Code,
+			Currency:           p.RoomType.Currency,
+			RoomDescription:    p.RoomType.Description,
+			AverageNightlyRate: average,
+			Nights:             int32(nights),
+			Charges:            charges,
+		},
+	}, nil
+}
+
+func (p *planInventory) exclusion(reason, format string, args ...interface{}) *rate.Exclusion {
+	return &rate.Exclusion{
+		HotelId:  p.HotelID,
+		Code:     p.Code,
+		RoomCode: p.RoomType.Code,
+		Reason:   reason,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
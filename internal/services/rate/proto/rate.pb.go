@@ -1,20 +1,17 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.26.0
-// 	protoc        v3.15.8
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
 // source: internal/services/rate/proto/rate.proto
 
 package proto
 
 import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -25,22 +22,26 @@ const (
 )
 
 type Request struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	HotelIds []string               `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
+	InDate   string                 `protobuf:"bytes,2,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate  string                 `protobuf:"bytes,3,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	// Only return plans with one of these rate codes, when set.
+	RateCodes []string `protobuf:"bytes,4,rep,name=rateCodes,proto3" json:"rateCodes,omitempty"`
+	// Only return plans for one of these room type codes, when set.
+	RoomCodes []string `protobuf:"bytes,5,rep,name=roomCodes,proto3" json:"roomCodes,omitempty"`
+	// ISO 4217 code of the currency to quote prices in. Empty keeps the
+	// currency of each plan.
+	Currency      string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	HotelIds []string `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
-	InDate   string   `protobuf:"bytes,2,opt,name=inDate,proto3" json:"inDate,omitempty"`
-	OutDate  string   `protobuf:"bytes,3,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Request) Reset() {
 	*x = Request{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_services_rate_proto_rate_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Request) String() string {
@@ -51,7 +52,7 @@ func (*Request) ProtoMessage() {}
 
 func (x *Request) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -87,21 +88,41 @@ func (x *Request) GetOutDate() string {
 	return ""
 }
 
+func (x *Request) GetRateCodes() []string {
+	if x != nil {
+		return x.RateCodes
+	}
+	return nil
+}
+
+func (x *Request) GetRoomCodes() []string {
+	if x != nil {
+		return x.RoomCodes
+	}
+	return nil
+}
+
+func (x *Request) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type Result struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RatePlans []*RatePlan            `protobuf:"bytes,1,rep,name=ratePlans,proto3" json:"ratePlans,omitempty"`
+	// Plans of the requested hotels that cannot be booked for the stay.
+	Exclusions    []*Exclusion `protobuf:"bytes,2,rep,name=exclusions,proto3" json:"exclusions,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	RatePlans []*RatePlan `protobuf:"bytes,1,rep,name=ratePlans,proto3" json:"ratePlans,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Result) Reset() {
 	*x = Result{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_services_rate_proto_rate_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Result) String() string {
@@ -112,7 +133,7 @@ func (*Result) ProtoMessage() {}
 
 func (x *Result) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -134,25 +155,109 @@ func (x *Result) GetRatePlans() []*RatePlan {
 	return nil
 }
 
-type RatePlan struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *Result) GetExclusions() []*Exclusion {
+	if x != nil {
+		return x.Exclusions
+	}
+	return nil
+}
+
+// Why a rate plan cannot be booked for the requested stay.
+type Exclusion struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	HotelId  string                 `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	Code     string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	RoomCode string                 `protobuf:"bytes,3,opt,name=roomCode,proto3" json:"roomCode,omitempty"`
+	// "UNAVAILABLE", "MIN_NIGHTS", "MAX_NIGHTS", "CLOSED_TO_ARRIVAL",
+	// "CLOSED_TO_DEPARTURE" or "SOLD_OUT".
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Readable explanation, e.g. "minimum stay 2 nights".
+	Message       string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-	HotelId  string    `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
-	Code     string    `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	InDate   string    `protobuf:"bytes,3,opt,name=inDate,proto3" json:"inDate,omitempty"`
-	OutDate  string    `protobuf:"bytes,4,opt,name=outDate,proto3" json:"outDate,omitempty"`
-	RoomType *RoomType `protobuf:"bytes,5,opt,name=roomType,proto3" json:"roomType,omitempty"`
+func (x *Exclusion) Reset() {
+	*x = Exclusion{}
+	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RatePlan) Reset() {
-	*x = RatePlan{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_services_rate_proto_rate_proto_msgTypes[2]
+func (x *Exclusion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Exclusion) ProtoMessage() {}
+
+func (x *Exclusion) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[2]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Exclusion.ProtoReflect.Descriptor instead.
+func (*Exclusion) Descriptor() ([]byte, []int) {
+	return file_internal_services_rate_proto_rate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Exclusion) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
 	}
+	return ""
+}
+
+func (x *Exclusion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Exclusion) GetRoomCode() string {
+	if x != nil {
+		return x.RoomCode
+	}
+	return ""
+}
+
+func (x *Exclusion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Exclusion) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RatePlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       string                 `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	InDate        string                 `protobuf:"bytes,3,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate       string                 `protobuf:"bytes,4,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	RoomType      *RoomType              `protobuf:"bytes,5,opt,name=roomType,proto3" json:"roomType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatePlan) Reset() {
+	*x = RatePlan{}
+	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RatePlan) String() string {
@@ -162,8 +267,8 @@ func (x *RatePlan) String() string {
 func (*RatePlan) ProtoMessage() {}
 
 func (x *RatePlan) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[3]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -175,7 +280,7 @@ func (x *RatePlan) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RatePlan.ProtoReflect.Descriptor instead.
 func (*RatePlan) Descriptor() ([]byte, []int) {
-	return file_internal_services_rate_proto_rate_proto_rawDescGZIP(), []int{2}
+	return file_internal_services_rate_proto_rate_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RatePlan) GetHotelId() string {
@@ -214,25 +319,38 @@ func (x *RatePlan) GetRoomType() *RoomType {
 }
 
 type RoomType struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BookableRate       float64 `protobuf:"fixed64,1,opt,name=bookableRate,proto3" json:"bookableRate,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Nightly rate, the average across the stay.
+	BookableRate float64 `protobuf:"fixed64,1,opt,name=bookableRate,proto3" json:"bookableRate,omitempty"`
+	// Sum of the nightly rates, before and after taxes.
 	TotalRate          float64 `protobuf:"fixed64,2,opt,name=totalRate,proto3" json:"totalRate,omitempty"`
 	TotalRateInclusive float64 `protobuf:"fixed64,3,opt,name=totalRateInclusive,proto3" json:"totalRateInclusive,omitempty"`
 	Code               string  `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
 	Currency           string  `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
 	RoomDescription    string  `protobuf:"bytes,6,opt,name=roomDescription,proto3" json:"roomDescription,omitempty"`
+	// Average nightly rate before taxes over the nights of the stay.
+	AverageNightlyRate float64 `protobuf:"fixed64,7,opt,name=averageNightlyRate,proto3" json:"averageNightlyRate,omitempty"`
+	Nights             int32   `protobuf:"varint,8,opt,name=nights,proto3" json:"nights,omitempty"`
+	// Prices as the plan is sold, set when they were converted to the
+	// requested currency.
+	Original *Price `protobuf:"bytes,9,opt,name=original,proto3" json:"original,omitempty"`
+	// Units of currency per unit of original.currency used to convert.
+	ExchangeRate float64 `protobuf:"fixed64,10,opt,name=exchangeRate,proto3" json:"exchangeRate,omitempty"`
+	// Taxes and fees for the stay, which make up the difference between
+	// totalRate and totalRateInclusive.
+	Charges []*Charge `protobuf:"bytes,11,rep,name=charges,proto3" json:"charges,omitempty"`
+	// Rooms of this type left to sell for every night of the stay, zero
+	// when the reservation service could not be reached.
+	RoomsLeft     int32 `protobuf:"varint,12,opt,name=roomsLeft,proto3" json:"roomsLeft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RoomType) Reset() {
 	*x = RoomType{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_services_rate_proto_rate_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RoomType) String() string {
@@ -242,8 +360,8 @@ func (x *RoomType) String() string {
 func (*RoomType) ProtoMessage() {}
 
 func (x *RoomType) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[4]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -255,7 +373,7 @@ func (x *RoomType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoomType.ProtoReflect.Descriptor instead.
 func (*RoomType) Descriptor() ([]byte, []int) {
-	return file_internal_services_rate_proto_rate_proto_rawDescGZIP(), []int{3}
+	return file_internal_services_rate_proto_rate_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RoomType) GetBookableRate() float64 {
@@ -300,236 +418,307 @@ func (x *RoomType) GetRoomDescription() string {
 	return ""
 }
 
-var File_internal_services_rate_proto_rate_proto protoreflect.FileDescriptor
-
-var file_internal_services_rate_proto_rate_proto_rawDesc = []byte{
-	0x0a, 0x27, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2f, 0x72, 0x61, 0x74, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72,
-	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22,
-	0x57, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f,
-	0x74, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f,
-	0x74, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x65, 0x22, 0x36, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x61, 0x74,
-	0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x73,
-	0x22, 0x96, 0x01, 0x0a, 0x08, 0x52, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x68, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69,
-	0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a,
-	0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x72, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x08, 0x52, 0x6f,
-	0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x6f, 0x6f, 0x6b, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x62, 0x6f,
-	0x6f, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x52, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x6f, 0x6f, 0x6d,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x72, 0x6f, 0x6f, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x32, 0x2f, 0x0a, 0x04, 0x52, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x47, 0x65,
-	0x74, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x72, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x72, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x42, 0x20, 0x5a, 0x1e, 0x2e, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x72, 0x61, 0x74, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *RoomType) GetAverageNightlyRate() float64 {
+	if x != nil {
+		return x.AverageNightlyRate
+	}
+	return 0
 }
 
-var (
-	file_internal_services_rate_proto_rate_proto_rawDescOnce sync.Once
-	file_internal_services_rate_proto_rate_proto_rawDescData = file_internal_services_rate_proto_rate_proto_rawDesc
-)
+func (x *RoomType) GetNights() int32 {
+	if x != nil {
+		return x.Nights
+	}
+	return 0
+}
 
-func file_internal_services_rate_proto_rate_proto_rawDescGZIP() []byte {
-	file_internal_services_rate_proto_rate_proto_rawDescOnce.Do(func() {
-		file_internal_services_rate_proto_rate_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_services_rate_proto_rate_proto_rawDescData)
-	})
-	return file_internal_services_rate_proto_rate_proto_rawDescData
+func (x *RoomType) GetOriginal() *Price {
+	if x != nil {
+		return x.Original
+	}
+	return nil
 }
 
-var file_internal_services_rate_proto_rate_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_internal_services_rate_proto_rate_proto_goTypes = []interface{}{
-	(*Request)(nil),  // 0: rate.Request
-	(*Result)(nil),   // 1: rate.Result
-	(*RatePlan)(nil), // 2: rate.RatePlan
-	(*RoomType)(nil), // 3: rate.RoomType
+func (x *RoomType) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
 }
-var file_internal_services_rate_proto_rate_proto_depIdxs = []int32{
-	2, // 0: rate.Result.ratePlans:type_name -> rate.RatePlan
-	3, // 1: rate.RatePlan.roomType:type_name -> rate.RoomType
-	0, // 2: rate.Rate.GetRates:input_type -> rate.Request
-	1, // 3: rate.Rate.GetRates:output_type -> rate.Result
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+func (x *RoomType) GetCharges() []*Charge {
+	if x != nil {
+		return x.Charges
+	}
+	return nil
 }
 
-func init() { file_internal_services_rate_proto_rate_proto_init() }
-func file_internal_services_rate_proto_rate_proto_init() {
-	if File_internal_services_rate_proto_rate_proto != nil {
-		return
+func (x *RoomType) GetRoomsLeft() int32 {
+	if x != nil {
+		return x.RoomsLeft
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_internal_services_rate_proto_rate_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Request); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_internal_services_rate_proto_rate_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Result); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_internal_services_rate_proto_rate_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RatePlan); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_internal_services_rate_proto_rate_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoomType); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return 0
+}
+
+type Charge struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Code        string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// "tax" or "fee".
+	Type          string  `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Amount        float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Charge) Reset() {
+	*x = Charge{}
+	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Charge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Charge) ProtoMessage() {}
+
+func (x *Charge) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_internal_services_rate_proto_rate_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_internal_services_rate_proto_rate_proto_goTypes,
-		DependencyIndexes: file_internal_services_rate_proto_rate_proto_depIdxs,
-		MessageInfos:      file_internal_services_rate_proto_rate_proto_msgTypes,
-	}.Build()
-	File_internal_services_rate_proto_rate_proto = out.File
-	file_internal_services_rate_proto_rate_proto_rawDesc = nil
-	file_internal_services_rate_proto_rate_proto_goTypes = nil
-	file_internal_services_rate_proto_rate_proto_depIdxs = nil
+	return mi.MessageOf(x)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+// Deprecated: Use Charge.ProtoReflect.Descriptor instead.
+func (*Charge) Descriptor() ([]byte, []int) {
+	return file_internal_services_rate_proto_rate_proto_rawDescGZIP(), []int{5}
+}
 
-// RateClient is the client API for Rate service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RateClient interface {
-	// GetRates returns rate codes for hotels for a given date range
-	GetRates(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error)
+func (x *Charge) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
 }
 
-type rateClient struct {
-	cc grpc.ClientConnInterface
+func (x *Charge) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func NewRateClient(cc grpc.ClientConnInterface) RateClient {
-	return &rateClient{cc}
+func (x *Charge) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (c *rateClient) GetRates(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error) {
-	out := new(Result)
-	err := c.cc.Invoke(ctx, "/rate.Rate/GetRates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (x *Charge) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
 	}
-	return out, nil
+	return 0
 }
 
-// RateServer is the server API for Rate service.
-type RateServer interface {
-	// GetRates returns rate codes for hotels for a given date range
-	GetRates(context.Context, *Request) (*Result, error)
+type Price struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Currency           string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	BookableRate       float64                `protobuf:"fixed64,2,opt,name=bookableRate,proto3" json:"bookableRate,omitempty"`
+	TotalRate          float64                `protobuf:"fixed64,3,opt,name=totalRate,proto3" json:"totalRate,omitempty"`
+	TotalRateInclusive float64                `protobuf:"fixed64,4,opt,name=totalRateInclusive,proto3" json:"totalRateInclusive,omitempty"`
+	AverageNightlyRate float64                `protobuf:"fixed64,5,opt,name=averageNightlyRate,proto3" json:"averageNightlyRate,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-// UnimplementedRateServer can be embedded to have forward compatible implementations.
-type UnimplementedRateServer struct {
+func (x *Price) Reset() {
+	*x = Price{}
+	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (*UnimplementedRateServer) GetRates(context.Context, *Request) (*Result, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRates not implemented")
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func RegisterRateServer(s *grpc.Server, srv RateServer) {
-	s.RegisterService(&_Rate_serviceDesc, srv)
+func (*Price) ProtoMessage() {}
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_rate_proto_rate_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func _Rate_GetRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_internal_services_rate_proto_rate_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Price) GetCurrency() string {
+	if x != nil {
+		return x.Currency
 	}
-	if interceptor == nil {
-		return srv.(RateServer).GetRates(ctx, in)
+	return ""
+}
+
+func (x *Price) GetBookableRate() float64 {
+	if x != nil {
+		return x.BookableRate
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rate.Rate/GetRates",
+	return 0
+}
+
+func (x *Price) GetTotalRate() float64 {
+	if x != nil {
+		return x.TotalRate
+	}
+	return 0
+}
+
+func (x *Price) GetTotalRateInclusive() float64 {
+	if x != nil {
+		return x.TotalRateInclusive
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RateServer).GetRates(ctx, req.(*Request))
+	return 0
+}
+
+func (x *Price) GetAverageNightlyRate() float64 {
+	if x != nil {
+		return x.AverageNightlyRate
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Rate_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "rate.Rate",
-	HandlerType: (*RateServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetRates",
-			Handler:    _Rate_GetRates_Handler,
+var File_internal_services_rate_proto_rate_proto protoreflect.FileDescriptor
+
+const file_internal_services_rate_proto_rate_proto_rawDesc = "" +
+	"\n" +
+	"'internal/services/rate/proto/rate.proto\x12\x04rate\"\xaf\x01\n" +
+	"\aRequest\x12\x1a\n" +
+	"\bhotelIds\x18\x01 \x03(\tR\bhotelIds\x12\x16\n" +
+	"\x06inDate\x18\x02 \x01(\tR\x06inDate\x12\x18\n" +
+	"\aoutDate\x18\x03 \x01(\tR\aoutDate\x12\x1c\n" +
+	"\trateCodes\x18\x04 \x03(\tR\trateCodes\x12\x1c\n" +
+	"\troomCodes\x18\x05 \x03(\tR\troomCodes\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\"g\n" +
+	"\x06Result\x12,\n" +
+	"\tratePlans\x18\x01 \x03(\v2\x0e.rate.RatePlanR\tratePlans\x12/\n" +
+	"\n" +
+	"exclusions\x18\x02 \x03(\v2\x0f.rate.ExclusionR\n" +
+	"exclusions\"\x87\x01\n" +
+	"\tExclusion\x12\x18\n" +
+	"\ahotelId\x18\x01 \x01(\tR\ahotelId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x1a\n" +
+	"\broomCode\x18\x03 \x01(\tR\broomCode\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\x96\x01\n" +
+	"\bRatePlan\x12\x18\n" +
+	"\ahotelId\x18\x01 \x01(\tR\ahotelId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x16\n" +
+	"\x06inDate\x18\x03 \x01(\tR\x06inDate\x12\x18\n" +
+	"\aoutDate\x18\x04 \x01(\tR\aoutDate\x12*\n" +
+	"\broomType\x18\x05 \x01(\v2\x0e.rate.RoomTypeR\broomType\"\xb1\x03\n" +
+	"\bRoomType\x12\"\n" +
+	"\fbookableRate\x18\x01 \x01(\x01R\fbookableRate\x12\x1c\n" +
+	"\ttotalRate\x18\x02 \x01(\x01R\ttotalRate\x12.\n" +
+	"\x12totalRateInclusive\x18\x03 \x01(\x01R\x12totalRateInclusive\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12(\n" +
+	"\x0froomDescription\x18\x06 \x01(\tR\x0froomDescription\x12.\n" +
+	"\x12averageNightlyRate\x18\a \x01(\x01R\x12averageNightlyRate\x12\x16\n" +
+	"\x06nights\x18\b \x01(\x05R\x06nights\x12'\n" +
+	"\boriginal\x18\t \x01(\v2\v.rate.PriceR\boriginal\x12\"\n" +
+	"\fexchangeRate\x18\n" +
+	" \x01(\x01R\fexchangeRate\x12&\n" +
+	"\acharges\x18\v \x03(\v2\f.rate.ChargeR\acharges\x12\x1c\n" +
+	"\troomsLeft\x18\f \x01(\x05R\troomsLeft\"j\n" +
+	"\x06Charge\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\"\xc5\x01\n" +
+	"\x05Price\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\"\n" +
+	"\fbookableRate\x18\x02 \x01(\x01R\fbookableRate\x12\x1c\n" +
+	"\ttotalRate\x18\x03 \x01(\x01R\ttotalRate\x12.\n" +
+	"\x12totalRateInclusive\x18\x04 \x01(\x01R\x12totalRateInclusive\x12.\n" +
+	"\x12averageNightlyRate\x18\x05 \x01(\x01R\x12averageNightlyRate2/\n" +
+	"\x04Rate\x12'\n" +
+	"\bGetRates\x12\r.rate.Request\x1a\f.rate.ResultBBZ@github.com/harlow/go-micro-services/internal/services/rate/protob\x06proto3"
+
+var (
+	file_internal_services_rate_proto_rate_proto_rawDescOnce sync.Once
+	file_internal_services_rate_proto_rate_proto_rawDescData []byte
+)
+
+func file_internal_services_rate_proto_rate_proto_rawDescGZIP() []byte {
+	file_internal_services_rate_proto_rate_proto_rawDescOnce.Do(func() {
+		file_internal_services_rate_proto_rate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_services_rate_proto_rate_proto_rawDesc), len(file_internal_services_rate_proto_rate_proto_rawDesc)))
+	})
+	return file_internal_services_rate_proto_rate_proto_rawDescData
+}
+
+var file_internal_services_rate_proto_rate_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_internal_services_rate_proto_rate_proto_goTypes = []any{
+	(*Request)(nil),   // 0: rate.Request
+	(*Result)(nil),    // 1: rate.Result
+	(*Exclusion)(nil), // 2: rate.Exclusion
+	(*RatePlan)(nil),  // 3: rate.RatePlan
+	(*RoomType)(nil),  // 4: rate.RoomType
+	(*Charge)(nil),    // 5: rate.Charge
+	(*Price)(nil),     // 6: rate.Price
+}
+var file_internal_services_rate_proto_rate_proto_depIdxs = []int32{
+	3, // 0: rate.Result.ratePlans:type_name -> rate.RatePlan
+	2, // 1: rate.Result.exclusions:type_name -> rate.Exclusion
+	4, // 2: rate.RatePlan.roomType:type_name -> rate.RoomType
+	6, // 3: rate.RoomType.original:type_name -> rate.Price
+	5, // 4: rate.RoomType.charges:type_name -> rate.Charge
+	0, // 5: rate.Rate.GetRates:input_type -> rate.Request
+	1, // 6: rate.Rate.GetRates:output_type -> rate.Result
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_internal_services_rate_proto_rate_proto_init() }
+func file_internal_services_rate_proto_rate_proto_init() {
+	if File_internal_services_rate_proto_rate_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_services_rate_proto_rate_proto_rawDesc), len(file_internal_services_rate_proto_rate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
 		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "internal/services/rate/proto/rate.proto",
+		GoTypes:           file_internal_services_rate_proto_rate_proto_goTypes,
+		DependencyIndexes: file_internal_services_rate_proto_rate_proto_depIdxs,
+		MessageInfos:      file_internal_services_rate_proto_rate_proto_msgTypes,
+	}.Build()
+	File_internal_services_rate_proto_rate_proto = out.File
+	file_internal_services_rate_proto_rate_proto_goTypes = nil
+	file_internal_services_rate_proto_rate_proto_depIdxs = nil
 }
@@ -1,35 +1,74 @@
 package rate
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
 
 	"github.com/harlow/go-micro-services/data"
+	"github.com/harlow/go-micro-services/internal/rpcerr"
 	runtime "github.com/harlow/go-micro-services/internal/runtime"
 	rate "github.com/harlow/go-micro-services/internal/services/rate/proto"
+	reservation "github.com/harlow/go-micro-services/internal/services/reservation/proto"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// New returns a new server
-func New() *Rate {
-	return &Rate{
-		rateTable: loadRateTable("data/inventory.json"),
+// maxStayNights bounds the length of a stay that can be priced.
+const maxStayNights = 30
+
+// New returns a new server. Rooms left to sell are looked up on the
+// reservation service within reservationTimeout. Prices are converted with
+// the exchange rates in the file at exchangeRatesPath, reloaded on SIGHUP,
+// or with the embedded rates when the path is empty.
+func New(reservationconn *grpc.ClientConn, reservationTimeout time.Duration, exchangeRatesPath string) *Rate {
+	inventory := loadInventory("data/inventory.json")
+	rates, err := loadExchangeRates(exchangeRatesPath)
+	if err == nil {
+		err = rates.covers(inventory)
+	}
+	if err != nil {
+		log.Fatalf("failed to load exchange rates: %v", err)
 	}
+
+	s := &Rate{
+		inventory:          inventory,
+		charges:            loadTaxes("data/taxes.json"),
+		ratesPath:          exchangeRatesPath,
+		reservationClient:  reservation.NewReservationClient(reservationconn),
+		reservationTimeout: reservationTimeout,
+	}
+	s.exchange.Store(&rates)
+	return s
 }
 
 // Rate implements the rate service
 type Rate struct {
-	rateTable map[stay]*rate.RatePlan
+	rate.UnimplementedRateServer
+	inventory map[string][]*planInventory
+	// taxes and fees of each hotel
+	charges   map[string][]chargeRule
+	ratesPath string
+	exchange  atomic.Pointer[exchangeRates]
+
+	reservationClient  reservation.ReservationClient
+	reservationTimeout time.Duration
 }
 
 // Run starts the server
 func (s *Rate) Run(port int) error {
 	srv := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(runtime.RejectWhileDraining),
 	)
 	rate.RegisterRateServer(srv, s)
 
@@ -38,51 +77,174 @@ func (s *Rate) Run(port int) error {
 		return fmt.Errorf("failed to listen: %w", err)
 	}
 
+	if s.ratesPath != "" {
+		hup := make(chan os.Signal, 1)
+		signal.Notify(hup, syscall.SIGHUP)
+		defer signal.Stop(hup)
+		go func() {
+			for range hup {
+				s.reloadExchangeRates()
+			}
+		}()
+	}
+
 	return runtime.ServeGRPCGracefully(lis, srv)
 }
 
+// reloadExchangeRates swaps in the exchange rates currently in the file.
+// A file that fails to load, or lacks a currency the inventory is priced
+// in, leaves the previous rates in place.
+func (s *Rate) reloadExchangeRates() {
+	rates, err := loadExchangeRates(s.ratesPath)
+	if err == nil {
+		err = rates.covers(s.inventory)
+	}
+	if err != nil {
+		log.Printf("exchange rates not reloaded: %v", err)
+		return
+	}
+	s.exchange.Store(&rates)
+	log.Printf("reloaded %d exchange rates from %s", len(rates), s.ratesPath)
+}
+
 // GetRates gets rates for hotels for specific date range.
 func (s *Rate) GetRates(ctx context.Context, req *rate.Request) (*rate.Result, error) {
+	in, out, err := validateStay(req.InDate, req.OutDate)
+	if err != nil {
+		return nil, err
+	}
+
+	target := strings.ToUpper(strings.TrimSpace(req.Currency))
+	var exchange exchangeRates
+	if target != "" {
+		if x := s.exchange.Load(); x != nil {
+			exchange = *x
+		}
+		if _, ok := exchange[target]; !ok {
+			return nil, rpcerr.InvalidArgument("currency", fmt.Sprintf("unsupported currency %q", req.Currency))
+		}
+	}
+
 	res := new(rate.Result)
 
+	var quoted []*rate.RatePlan
 	for _, hotelID := range req.HotelIds {
-		stay := stay{
-			HotelID: hotelID,
-			InDate:  req.InDate,
-			OutDate: req.OutDate,
+		for _, plan := range s.inventory[hotelID] {
+			if !matchesCode(plan.Code, req.RateCodes) || !matchesCode(plan.RoomType.Code, req.RoomCodes) {
+				continue
+			}
+			ratePlan, excluded := plan.quote(in, out, s.charges[hotelID])
+			if excluded != nil {
+				res.Exclusions = append(res.Exclusions, excluded)
+				continue
+			}
+			quoted = append(quoted, ratePlan)
+		}
+	}
+
+	roomsLeft := s.roomsLeft(ctx, quoted, req.InDate, req.OutDate)
+	for _, ratePlan := range quoted {
+		rt := ratePlan.RoomType
+		if left, ok := roomsLeft[[2]string{ratePlan.HotelId, rt.Code}]; ok {
+			if left <= 0 {
+				res.Exclusions = append(res.Exclusions, &rate.Exclusion{
+					HotelId:  ratePlan.HotelId,
+					Code:     ratePlan.Code,
+					RoomCode: rt.Code,
+					Reason:   reasonSoldOut,
+					Message:  "sold out",
+				})
+				continue
+			}
+			rt.RoomsLeft = left
 		}
-		if s.rateTable[stay] != nil {
-			res.RatePlans = append(res.RatePlans, s.rateTable[stay])
+		if target != "" {
+			if ratePlan, err = exchange.convert(ratePlan, target); err != nil {
+				log.Printf("rate conversion failed: %v", err)
+				return nil, status.Error(codes.Internal, "failed to convert rates")
+			}
 		}
+		res.RatePlans = append(res.RatePlans, ratePlan)
 	}
 
 	return res, nil
 }
 
-// loadRates loads rate codes from JSON file.
-func loadRateTable(path string) map[stay]*rate.RatePlan {
-	file := data.MustAsset(path)
+// roomsLeft asks the reservation service how many rooms of each type of
+// the plans' hotels are left for the stay, keyed by hotel and room code.
+// Room types it does not track are missing from the result. When the
+// service cannot be reached every plan is left bookable, since rates are
+// still worth showing without availability.
+func (s *Rate) roomsLeft(ctx context.Context, plans []*rate.RatePlan, inDate, outDate string) map[[2]string]int32 {
+	if s.reservationClient == nil || len(plans) == 0 {
+		return nil
+	}
 
-	rates := []*rate.RatePlan{}
-	if err := json.Unmarshal(file, &rates); err != nil {
-		log.Fatalf("Failed to load json: %v", err)
+	req := &reservation.AvailabilityRequest{InDate: inDate, OutDate: outDate}
+	seen := make(map[string]bool)
+	for _, p := range plans {
+		if !seen[p.HotelId] {
+			seen[p.HotelId] = true
+			req.HotelIds = append(req.HotelIds, p.HotelId)
+		}
 	}
 
-	rateTable := make(map[stay]*rate.RatePlan)
-	for _, ratePlan := range rates {
-		stay := stay{
-			HotelID: ratePlan.HotelId,
-			InDate:  ratePlan.InDate,
-			OutDate: ratePlan.OutDate,
+	ctx, cancel := runtime.WithBudget(ctx, s.reservationTimeout)
+	defer cancel()
+	res, err := s.reservationClient.Availability(ctx, req)
+	if err != nil {
+		log.Printf("availability unknown: %v", err)
+		return nil
+	}
+
+	left := make(map[[2]string]int32, len(res.Rooms))
+	for _, r := range res.Rooms {
+		left[[2]string{r.HotelId, r.RoomCode}] = r.Available
+	}
+	return left
+}
+
+// matchesCode reports whether code is one of codes, ignoring case. An empty
+// filter matches every code.
+func matchesCode(code string, codes []string) bool {
+	if len(codes) == 0 {
+		return true
+	}
+	for _, c := range codes {
+		if strings.EqualFold(c, code) {
+			return true
 		}
-		rateTable[stay] = ratePlan
 	}
+	return false
+}
 
-	return rateTable
+// validateStay checks the stay dates are well formed, in order and no
+// longer than maxStayNights.
+func validateStay(inDate, outDate string) (time.Time, time.Time, error) {
+	in, err := time.Parse(dateFmt, inDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, rpcerr.InvalidArgument("inDate", "expected YYYY-MM-DD")
+	}
+	out, err := time.Parse(dateFmt, outDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, rpcerr.InvalidArgument("outDate", "expected YYYY-MM-DD")
+	}
+	if !out.After(in) {
+		return time.Time{}, time.Time{}, rpcerr.InvalidArgument("outDate", "must be after inDate")
+	}
+	if out.After(in.AddDate(0, 0, maxStayNights)) {
+		return time.Time{}, time.Time{}, rpcerr.InvalidArgument("outDate", fmt.Sprintf("stays are limited to %d nights", maxStayNights))
+	}
+	return in, out, nil
 }
 
-type stay struct {
-	HotelID string
-	InDate  string
-	OutDate string
+// loadInventory loads nightly rates from JSON file.
+func loadInventory(path string) map[string][]*planInventory {
+	file := data.MustAsset(path)
+
+	inventory, err := parseInventory(path, file)
+	if err != nil {
+		log.Fatalf("failed to load inventory: %v", err)
+	}
+	return inventory
 }
@@ -1,16 +1,25 @@
 package rate
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/harlow/go-micro-services/data"
+	"github.com/harlow/go-micro-services/internal/dataload"
 	ratepb "github.com/harlow/go-micro-services/internal/services/rate/proto"
+	reservationpb "github.com/harlow/go-micro-services/internal/services/reservation/proto"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestGetRatesReturnsOnlyMatchingStays(t *testing.T) {
-	s := &Rate{rateTable: map[stay]*ratepb.RatePlan{
-		{HotelID: "1", InDate: "2015-04-09", OutDate: "2015-04-10"}: {HotelId: "1"},
-		{HotelID: "2", InDate: "2015-04-09", OutDate: "2015-04-10"}: {HotelId: "2"},
+	s := &Rate{inventory: map[string][]*planInventory{
+		"1": {{HotelID: "1", Nights: map[string]night{"2015-04-09": {Rate: 109}}}},
+		"2": {{HotelID: "2", Nights: map[string]night{"2015-04-09": {Rate: 139}}}},
 	}}
 
 	res, err := s.GetRates(context.Background(), &ratepb.Request{
@@ -28,3 +37,427 @@ func TestGetRatesReturnsOnlyMatchingStays(t *testing.T) {
 		t.Fatalf("unexpected hotel id order: %q, %q", res.RatePlans[0].HotelId, res.RatePlans[1].HotelId)
 	}
 }
+
+func TestGetRatesComposesStayFromNights(t *testing.T) {
+	s := &Rate{inventory: map[string][]*planInventory{
+		"1": {{
+			HotelID:  "1",
+			Code:     "RACK",
+			RoomType: roomType{Code: "KNG", Description: "King sized bed"},
+			Nights: map[string]night{
+				"2015-04-09": {Rate: 109},
+				"2015-04-10": {Rate: 139},
+				"2015-04-11": {Rate: 139},
+			},
+		}},
+		// missing the night of 2015-04-10
+		"2": {{
+			HotelID: "2",
+			Nights: map[string]night{
+				"2015-04-09": {Rate: 139},
+				"2015-04-11": {Rate: 169},
+			},
+		}},
+	}, charges: map[string][]chargeRule{
+		"1": {
+			{Code: "TOT", Type: chargeTax, Percent: 14},
+			{Code: "URBAN", Type: chargeFee, PerNight: 25},
+			{Code: "CLEAN", Type: chargeFee, PerStay: 15},
+		},
+	}}
+
+	res, err := s.GetRates(context.Background(), &ratepb.Request{
+		HotelIds: []string{"1", "2"},
+		InDate:   "2015-04-09",
+		OutDate:  "2015-04-12",
+	})
+	if err != nil {
+		t.Fatalf("GetRates returned error: %v", err)
+	}
+	if len(res.RatePlans) != 1 {
+		t.Fatalf("expected 1 rate plan, got %d", len(res.RatePlans))
+	}
+	if len(res.Exclusions) != 1 || res.Exclusions[0].HotelId != "2" || res.Exclusions[0].Message != "not available on 2015-04-10" {
+		t.Fatalf("unexpected exclusions: %v", res.Exclusions)
+	}
+
+	plan := res.RatePlans[0]
+	rt := plan.RoomType
+	if plan.InDate != "2015-04-09" || plan.OutDate != "2015-04-12" || rt.Nights != 3 {
+		t.Fatalf("unexpected stay: %v", plan)
+	}
+	if rt.TotalRate != 387 || rt.TotalRateInclusive != 531.18 || rt.AverageNightlyRate != 129 || rt.BookableRate != 129 {
+		t.Fatalf("unexpected prices: %v", rt)
+	}
+	if len(rt.Charges) != 3 || rt.Charges[0].Amount != 54.18 || rt.Charges[1].Amount != 75 || rt.Charges[2].Amount != 15 {
+		t.Fatalf("unexpected charges: %v", rt.Charges)
+	}
+	if rt.Code != "KNG" || rt.RoomDescription != "King sized bed" {
+		t.Fatalf("unexpected room type: %v", rt)
+	}
+}
+
+func TestGetRatesValidatesStay(t *testing.T) {
+	s := &Rate{}
+
+	for _, tt := range [][2]string{
+		{"2015-04-09", "2015-04-09"},
+		{"2015-04-09", "2015-05-10"},
+		{"04/09/2015", "2015-04-10"},
+	} {
+		_, err := s.GetRates(context.Background(), &ratepb.Request{InDate: tt[0], OutDate: tt[1]})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%v: error code = %v, want InvalidArgument", tt, status.Code(err))
+		}
+	}
+}
+
+func TestParseInventoryExpandsPeriods(t *testing.T) {
+	inventory, err := parseInventory("data/inventory.json", data.MustAsset("data/inventory.json"))
+	if err != nil {
+		t.Fatalf("parseInventory: %v", err)
+	}
+
+	nights := inventory["1"][0].Nights
+	if len(nights) != 30 {
+		t.Fatalf("hotel 1 has %d nights, want 30", len(nights))
+	}
+	if nights["2015-04-09"].Rate != 109 || nights["2015-04-10"].Rate != 139 || nights["2015-04-12"].Rate != 109 {
+		t.Fatalf("unexpected nightly rates: %v", nights)
+	}
+	if _, ok := nights["2015-05-01"]; ok {
+		t.Fatalf("period end should be exclusive")
+	}
+}
+
+func TestGetRatesFiltersPlans(t *testing.T) {
+	s := &Rate{inventory: map[string][]*planInventory{
+		"1": {
+			{HotelID: "1", Code: "RACK", RoomType: roomType{Code: "KNG"}, Nights: map[string]night{"2015-04-09": {Rate: 109}}},
+			{HotelID: "1", Code: "AAA", RoomType: roomType{Code: "KNG"}, Nights: map[string]night{"2015-04-09": {Rate: 98}}},
+			{HotelID: "1", Code: "RACK", RoomType: roomType{Code: "QN"}, Nights: map[string]night{"2015-04-09": {Rate: 100}}},
+		},
+	}}
+
+	for _, tt := range []struct {
+		rateCodes, roomCodes []string
+		want                 int
+	}{
+		{nil, nil, 3},
+		{[]string{"rack"}, nil, 2},
+		{nil, []string{"QN"}, 1},
+		{[]string{"AAA"}, []string{"QN"}, 0},
+	} {
+		res, err := s.GetRates(context.Background(), &ratepb.Request{
+			HotelIds:  []string{"1"},
+			InDate:    "2015-04-09",
+			OutDate:   "2015-04-10",
+			RateCodes: tt.rateCodes,
+			RoomCodes: tt.roomCodes,
+		})
+		if err != nil {
+			t.Fatalf("GetRates returned error: %v", err)
+		}
+		if len(res.RatePlans) != tt.want {
+			t.Fatalf("rate codes %v, room codes %v: got %d plans, want %d", tt.rateCodes, tt.roomCodes, len(res.RatePlans), tt.want)
+		}
+	}
+}
+
+func TestParseInventoryRejectsDuplicatePlans(t *testing.T) {
+	plan := `{"hotelId": "1", "code": "RACK", "roomType": {"code": "KNG", "currency": "USD"}, "nights": []}`
+	if _, err := parseInventory("inventory.json", []byte("["+plan+","+plan+"]")); err == nil {
+		t.Fatal("expected an error for a duplicate plan")
+	}
+}
+
+func TestParseInventoryRejectsOverlappingPeriods(t *testing.T) {
+	in := `[{"hotelId": "1", "code": "RACK", "roomType": {"code": "KNG", "currency": "USD"}, "nights": [
+		{"from": "2015-04-01", "to": "2015-04-10", "rate": 109},
+		{"from": "2015-04-09", "to": "2015-04-12", "rate": 139}
+	]}]`
+	want := "inventory.json[0].nights[1]: 2015-04-09 is already priced by nights[0]"
+	if _, err := parseInventory("inventory.json", []byte(in)); err == nil || err.Error() != want {
+		t.Fatalf("error = %v, want %q", err, want)
+	}
+}
+
+func TestParseInventoryRejectsBadRecords(t *testing.T) {
+	for _, tt := range []struct {
+		in, field string
+	}{
+		{`[{"hotelId": "1", "code": "RACK", "roomType": {"code": "KNG", "roomDescription": "King"}, "nights": []}]`, "roomType.roomDescription"},
+		{`[{"hotelId": "1", "code": "RACK", "roomType": {"code": "KNG", "currency": "USD"}, "nights": [{"from": "2015-04-31", "to": "2015-05-01", "rate": 1}]}]`, "nights[0].from"},
+		{`[{"hotelId": "1", "code": "RACK", "roomType": {"code": "KNG", "currency": "USD"}, "nights": [{"from": "2015-04-01", "to": "2015-05-01", "rate": 0}]}]`, "nights[0].rate"},
+		{`[{"hotelId": "1", "code": "RACK", "roomType": {"code": "KNG", "currency": "USD"}, "nights": [{"from": "2015-04-01", "to": "2015-05-01", "rate": 10, "rateInclusive": 9}]}]`, "nights[0].rateInclusive"},
+		{`[{"hotelId": "1", "code": "RACK", "roomType": {"code": "KNG", "currency": "usd"}, "nights": []}]`, "roomType.currency"},
+		{`[{"hotelId": "1", "code": "", "roomType": {"code": "KNG", "currency": "USD"}, "nights": []}]`, "code"},
+	} {
+		_, err := parseInventory("inventory.json", []byte(tt.in))
+		var e *dataload.Error
+		if !errors.As(err, &e) || e.File != "inventory.json" || e.Index != 0 || e.Field != tt.field {
+			t.Fatalf("%s: error = %v, want one at inventory.json[0].%s", tt.in, err, tt.field)
+		}
+	}
+}
+
+func TestGetRatesConvertsCurrency(t *testing.T) {
+	exchange, err := parseExchangeRates("rates.json", []byte(`[
+		{"currency": "USD", "rate": 1, "minorUnits": 2},
+		{"currency": "EUR", "rate": 0.9215, "minorUnits": 2},
+		{"currency": "JPY", "rate": 151.37, "minorUnits": 0}
+	]`))
+	if err != nil {
+		t.Fatalf("parseExchangeRates: %v", err)
+	}
+	s := &Rate{inventory: map[string][]*planInventory{
+		"1": {{
+			HotelID:  "1",
+			Code:     "RACK",
+			RoomType: roomType{Code: "KNG", Currency: "USD"},
+			Nights:   map[string]night{"2015-04-09": {Rate: 109}},
+		}},
+	}, charges: map[string][]chargeRule{
+		"1": {{Code: "TOT", Type: chargeTax, Percent: 13}},
+	}}
+	s.exchange.Store(&exchange)
+
+	for _, tt := range []struct {
+		currency        string
+		rate, inclusive float64
+	}{
+		{"eur", 100.44, 113.5},
+		{"JPY", 16499, 18644},
+		{"USD", 109, 123.17},
+	} {
+		res, err := s.GetRates(context.Background(), &ratepb.Request{
+			HotelIds: []string{"1"},
+			InDate:   "2015-04-09",
+			OutDate:  "2015-04-10",
+			Currency: tt.currency,
+		})
+		if err != nil {
+			t.Fatalf("%s: GetRates returned error: %v", tt.currency, err)
+		}
+		rt := res.RatePlans[0].RoomType
+		if rt.TotalRate != tt.rate || rt.TotalRateInclusive != tt.inclusive || rt.BookableRate != tt.rate {
+			t.Fatalf("%s: unexpected prices: %v", tt.currency, rt)
+		}
+		if len(rt.Charges) != 1 || roundCents(rt.TotalRate+rt.Charges[0].Amount) != rt.TotalRateInclusive {
+			t.Fatalf("%s: charges do not add up: %v", tt.currency, rt)
+		}
+		if tt.currency == "USD" {
+			if rt.Original != nil {
+				t.Fatalf("unconverted plan has original prices: %v", rt.Original)
+			}
+			continue
+		}
+		if rt.Original.GetCurrency() != "USD" || rt.Original.GetTotalRate() != 109 || rt.ExchangeRate == 0 {
+			t.Fatalf("%s: unexpected original prices: %v", tt.currency, rt.Original)
+		}
+	}
+
+	_, err = s.GetRates(context.Background(), &ratepb.Request{
+		HotelIds: []string{"1"},
+		InDate:   "2015-04-09",
+		OutDate:  "2015-04-10",
+		Currency: "XYZ",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("error code = %v, want InvalidArgument", status.Code(err))
+	}
+}
+
+func TestRoundDecimalRoundsHalfAwayFromZero(t *testing.T) {
+	for _, tt := range []struct {
+		in     float64
+		places int
+		want   float64
+	}{
+		{1.005, 2, 1.01},
+		{2.675, 2, 2.68},
+		{-1.005, 2, -1.01},
+		{16499.5, 0, 16500},
+		{0.0005, 3, 0.001},
+	} {
+		if got := roundDecimal(decimal(tt.in), tt.places); got != tt.want {
+			t.Fatalf("roundDecimal(%v, %d) = %v, want %v", tt.in, tt.places, got, tt.want)
+		}
+	}
+}
+
+func TestReloadExchangeRates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	if err := os.WriteFile(path, []byte(`[{"currency": "USD", "rate": 1, "minorUnits": 2}]`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	exchange, err := loadExchangeRates(path)
+	if err != nil {
+		t.Fatalf("loadExchangeRates: %v", err)
+	}
+	s := &Rate{ratesPath: path, inventory: map[string][]*planInventory{
+		"1": {{HotelID: "1", Code: "RACK", RoomType: roomType{Code: "KNG", Currency: "USD"}}},
+	}}
+	s.exchange.Store(&exchange)
+
+	// a bad file keeps the current rates
+	if err := os.WriteFile(path, []byte(`[{"currency": "EUR", "rate": -1, "minorUnits": 2}]`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	s.reloadExchangeRates()
+	if _, ok := (*s.exchange.Load())["USD"]; !ok {
+		t.Fatalf("bad file replaced the exchange rates")
+	}
+
+	// so does a file without the currency the inventory is priced in
+	if err := os.WriteFile(path, []byte(`[{"currency": "EUR", "rate": 0.9, "minorUnits": 2}]`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	s.reloadExchangeRates()
+	if _, ok := (*s.exchange.Load())["USD"]; !ok {
+		t.Fatalf("file without USD replaced the exchange rates")
+	}
+
+	if err := os.WriteFile(path, []byte(`[{"currency": "USD", "rate": 1, "minorUnits": 2}, {"currency": "EUR", "rate": 0.9, "minorUnits": 2}]`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	s.reloadExchangeRates()
+	if _, ok := (*s.exchange.Load())["EUR"]; !ok {
+		t.Fatalf("exchange rates not reloaded")
+	}
+}
+
+func TestParseTaxesValidatesCharges(t *testing.T) {
+	charges, err := parseTaxes("data/taxes.json", data.MustAsset("data/taxes.json"))
+	if err != nil {
+		t.Fatalf("embedded taxes are invalid: %v", err)
+	}
+	if codes := len(charges["15"]); codes != 4 {
+		t.Fatalf("hotel 15 has %d charges, want 4", codes)
+	}
+
+	for _, tt := range []struct {
+		in, want string
+	}{
+		{`[{"name": "SF", "hotelIds": ["1"], "charges": [{"code": "TOT", "type": "levy", "percent": 14}]}]`, `taxes.json[0].charges[0].type: "levy" must be tax or fee`},
+		{`[{"name": "SF", "hotelIds": ["1"], "charges": [{"code": "TOT", "type": "tax", "percent": 14, "perNight": 2}]}]`, "taxes.json[0].charges[0]: needs exactly one of percent, perNight or perStay"},
+		{`[{"name": "SF", "hotelIds": [], "charges": []}]`, "taxes.json[0].hotelIds: is required"},
+		{`[{"name": "SF", "hotelIds": ["1"], "charges": [{"code": "TOT", "type": "tax", "percent": 14}]}, {"name": "SF", "hotelIds": ["1"], "charges": [{"code": "TOT", "type": "tax", "percent": 14}]}]`, "taxes.json[1]: hotel 1 is already charged TOT"},
+	} {
+		_, err := parseTaxes("taxes.json", []byte(tt.in))
+		if err == nil || err.Error() != tt.want {
+			t.Fatalf("%s: error = %v, want %q", tt.in, err, tt.want)
+		}
+	}
+}
+
+func TestChargeAmountRoundsInDecimal(t *testing.T) {
+	c := chargeRule{Code: "TID", Type: chargeTax, Percent: 1.5}
+	if got := c.amount(109, 1); got != 1.64 {
+		t.Fatalf("1.5%% of 109 = %v, want 1.64", got)
+	}
+}
+
+func TestGetRatesEnforcesRestrictions(t *testing.T) {
+	s := &Rate{inventory: map[string][]*planInventory{
+		"1": {{
+			HotelID: "1",
+			Code:    "RACK",
+			Nights: map[string]night{
+				"2015-04-09": {Rate: 109, MinNights: 2, MaxNights: 3},
+				"2015-04-10": {Rate: 139, ClosedToArrival: true},
+				"2015-04-11": {Rate: 139, ClosedToDeparture: true},
+				"2015-04-12": {Rate: 109},
+				"2015-04-13": {Rate: 109, ClosedToDeparture: true},
+			},
+		}},
+	}}
+
+	for _, tt := range []struct {
+		in, out, reason string
+	}{
+		{"2015-04-09", "2015-04-10", reasonMinNights},
+		{"2015-04-09", "2015-04-13", reasonMaxNights},
+		{"2015-04-10", "2015-04-12", reasonClosedToArrival},
+		{"2015-04-09", "2015-04-11", reasonClosedToDeparture},
+		{"2015-04-12", "2015-04-13", reasonClosedToDeparture},
+		{"2015-04-13", "2015-04-15", reasonUnavailable},
+		{"2015-04-09", "2015-04-12", ""},
+	} {
+		res, err := s.GetRates(context.Background(), &ratepb.Request{HotelIds: []string{"1"}, InDate: tt.in, OutDate: tt.out})
+		if err != nil {
+			t.Fatalf("%s to %s: GetRates returned error: %v", tt.in, tt.out, err)
+		}
+		if tt.reason == "" {
+			if len(res.RatePlans) != 1 || len(res.Exclusions) != 0 {
+				t.Fatalf("%s to %s: expected a bookable plan, got %v", tt.in, tt.out, res)
+			}
+			continue
+		}
+		if len(res.RatePlans) != 0 || len(res.Exclusions) != 1 || res.Exclusions[0].Reason != tt.reason {
+			t.Fatalf("%s to %s: expected exclusion %s, got %v", tt.in, tt.out, tt.reason, res)
+		}
+	}
+}
+
+type reservationClientStub struct {
+	reservationpb.ReservationClient
+	req *reservationpb.AvailabilityRequest
+	res *reservationpb.AvailabilityResult
+	err error
+}
+
+func (r *reservationClientStub) Availability(ctx context.Context, in *reservationpb.AvailabilityRequest, opts ...grpc.CallOption) (*reservationpb.AvailabilityResult, error) {
+	r.req = in
+	return r.res, r.err
+}
+
+func TestGetRatesReportsRoomsLeft(t *testing.T) {
+	nights := map[string]night{"2015-04-09": {Rate: 109}}
+	stub := &reservationClientStub{res: &reservationpb.AvailabilityResult{Rooms: []*reservationpb.RoomAvailability{
+		{HotelId: "1", RoomCode: "KNG", Available: 3},
+		{HotelId: "1", RoomCode: "QN", Available: 0},
+	}}}
+	s := &Rate{
+		inventory: map[string][]*planInventory{
+			"1": {
+				{HotelID: "1", Code: "RACK", RoomType: roomType{Code: "KNG"}, Nights: nights},
+				{HotelID: "1", Code: "RACK", RoomType: roomType{Code: "QN"}, Nights: nights},
+			},
+			// room types the reservation service does not track stay bookable
+			"2": {{HotelID: "2", Code: "RACK", RoomType: roomType{Code: "KNG"}, Nights: nights}},
+		},
+		reservationClient: stub,
+	}
+	req := &ratepb.Request{HotelIds: []string{"1", "2"}, InDate: "2015-04-09", OutDate: "2015-04-10"}
+
+	res, err := s.GetRates(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetRates returned error: %v", err)
+	}
+	if got := stub.req.HotelIds; len(got) != 2 || got[0] != "1" || got[1] != "2" {
+		t.Fatalf("expected availability for hotels 1 and 2, got %v", got)
+	}
+	if len(res.RatePlans) != 2 {
+		t.Fatalf("expected 2 rate plans, got %d", len(res.RatePlans))
+	}
+	if got := res.RatePlans[0].RoomType; got.Code != "KNG" || got.RoomsLeft != 3 {
+		t.Fatalf("expected 3 kings left at hotel 1, got %+v", got)
+	}
+	if got := res.RatePlans[1].RoomType.RoomsLeft; got != 0 {
+		t.Fatalf("expected untracked rooms left unset, got %d", got)
+	}
+	if len(res.Exclusions) != 1 || res.Exclusions[0].RoomCode != "QN" || res.Exclusions[0].Reason != reasonSoldOut {
+		t.Fatalf("expected the queens to be sold out, got %v", res.Exclusions)
+	}
+
+	// rates are still served when availability is unknown
+	stub.err = status.Error(codes.Unavailable, "reservation down")
+	res, err = s.GetRates(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetRates returned error: %v", err)
+	}
+	if len(res.RatePlans) != 3 || len(res.Exclusions) != 0 {
+		t.Fatalf("expected every plan without availability, got %v", res)
+	}
+}
@@ -0,0 +1,144 @@
+package rate
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+
+	"github.com/harlow/go-micro-services/data"
+	"github.com/harlow/go-micro-services/internal/dataload"
+	rate "github.com/harlow/go-micro-services/internal/services/rate/proto"
+)
+
+const (
+	chargeTax = "tax"
+	chargeFee = "fee"
+)
+
+// chargeRule is a tax or fee levied on a stay. It is either a percentage
+// of the room rate or a flat amount per night or per stay, in the currency
+// of the rate plan.
+type chargeRule struct {
+	Code        string  `json:"code"`
+	Description string  `json:"description"`
+	Type        string  `json:"type"`
+	Percent     float64 `json:"percent"`
+	PerNight    float64 `json:"perNight"`
+	PerStay     float64 `json:"perStay"`
+}
+
+// taxRecord is how taxes.json groups charges: a jurisdiction, or a single
+// hotel's own fees, with the hotels it applies to.
+type taxRecord struct {
+	Name     string       `json:"name"`
+	HotelIDs []string     `json:"hotelIds"`
+	Charges  []chargeRule `json:"charges"`
+}
+
+// loadTaxes loads the charges of each hotel from a JSON file.
+func loadTaxes(path string) map[string][]chargeRule {
+	charges, err := parseTaxes(path, data.MustAsset(path))
+	if err != nil {
+		log.Fatalf("failed to load taxes: %v", err)
+	}
+	return charges
+}
+
+// parseTaxes reads the tax records in file and returns the charges of
+// each hotel, in file order. A hotel cannot be charged the same code twice.
+func parseTaxes(file string, b []byte) (map[string][]chargeRule, error) {
+	records, err := dataload.Records(file, b, checkTaxRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	charges := make(map[string][]chargeRule)
+	for i, r := range records {
+		for _, id := range r.HotelIDs {
+			for _, c := range r.Charges {
+				for _, prev := range charges[id] {
+					if prev.Code == c.Code {
+						return nil, &dataload.Error{File: file, Index: i, Msg: fmt.Sprintf("hotel %s is already charged %s", id, c.Code)}
+					}
+				}
+				charges[id] = append(charges[id], c)
+			}
+		}
+	}
+	return charges, nil
+}
+
+// checkTaxRecord validates the values of a tax record.
+func checkTaxRecord(r *taxRecord) error {
+	if err := dataload.Required("name", r.Name); err != nil {
+		return err
+	}
+	if len(r.HotelIDs) == 0 {
+		return dataload.Invalid("hotelIds", "is required")
+	}
+	for j, id := range r.HotelIDs {
+		if err := dataload.Required(fmt.Sprintf("hotelIds[%d]", j), id); err != nil {
+			return err
+		}
+	}
+
+	for j, c := range r.Charges {
+		field := fmt.Sprintf("charges[%d]", j)
+		if err := dataload.Required(field+".code", c.Code); err != nil {
+			return err
+		}
+		if c.Type != chargeTax && c.Type != chargeFee {
+			return dataload.Invalid(field+".type", "%q must be %s or %s", c.Type, chargeTax, chargeFee)
+		}
+
+		bases := 0
+		for _, v := range []float64{c.Percent, c.PerNight, c.PerStay} {
+			if v < 0 {
+				return dataload.Invalid(field, "amounts must not be negative")
+			}
+			if v > 0 {
+				bases++
+			}
+		}
+		if bases != 1 {
+			return dataload.Invalid(field, "needs exactly one of percent, perNight or perStay")
+		}
+		if c.Percent > 100 {
+			return dataload.Invalid(field+".percent", "%v is out of range [0, 100]", c.Percent)
+		}
+	}
+	return nil
+}
+
+// amount returns the charge for a stay of nights nights costing total
+// before taxes, rounded to cents.
+func (c chargeRule) amount(total float64, nights int) float64 {
+	switch {
+	case c.Percent > 0:
+		// in decimal, so 1.5% of 109 is 1.64 rather than 1.63
+		pct := new(big.Rat).Mul(decimal(total), decimal(c.Percent))
+		return roundDecimal(pct.Quo(pct, big.NewRat(100, 1)), 2)
+	case c.PerNight > 0:
+		return roundCents(c.PerNight * float64(nights))
+	default:
+		return roundCents(c.PerStay)
+	}
+}
+
+// applyCharges itemizes rules for a stay of nights nights costing total
+// before taxes and returns them with the total including them.
+func applyCharges(rules []chargeRule, total float64, nights int) ([]*rate.Charge, float64) {
+	charges := make([]*rate.Charge, 0, len(rules))
+	inclusive := total
+	for _, r := range rules {
+		amount := r.amount(total, nights)
+		charges = append(charges, &rate.Charge{
+			Code:        r.Code,
+			Description: r.Description,
+			Type:        r.Type,
+			Amount:      amount,
+		})
+		inclusive += amount
+	}
+	return charges, roundCents(inclusive)
+}
@@ -0,0 +1,97 @@
+package reservation
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/harlow/go-micro-services/data"
+	"github.com/harlow/go-micro-services/internal/dataload"
+)
+
+const dateFmt = "2006-01-02"
+
+// allotmentRecord is how a room type's inventory is stored in
+// allotments.json: the number of rooms for sale, given as periods of
+// nights.
+type allotmentRecord struct {
+	HotelID  string `json:"hotelId"`
+	RoomCode string `json:"roomCode"`
+	Nights   []struct {
+		From  string `json:"from"`
+		To    string `json:"to"`
+		Rooms int    `json:"rooms"`
+	} `json:"nights"`
+}
+
+// loadAllotments loads the rooms for sale each night from a JSON file.
+func loadAllotments(path string) map[roomKey]map[string]int {
+	rooms, err := parseAllotments(path, data.MustAsset(path))
+	if err != nil {
+		log.Fatalf("failed to load allotments: %v", err)
+	}
+	return rooms
+}
+
+// parseAllotments reads allotment records from file and expands their
+// periods into rooms per night, keyed by hotel and room type. Periods run
+// from the night of From up to, but not including, the night of To, and
+// must not overlap.
+func parseAllotments(file string, b []byte) (map[roomKey]map[string]int, error) {
+	records, err := dataload.Records(file, b, checkAllotment)
+	if err != nil {
+		return nil, err
+	}
+
+	rooms := make(map[roomKey]map[string]int)
+	for i, r := range records {
+		key := roomKey{hotelID: r.HotelID, roomCode: r.RoomCode}
+		if _, ok := rooms[key]; ok {
+			return nil, &dataload.Error{File: file, Index: i, Msg: fmt.Sprintf("hotel %s already has allotments for room type %s", r.HotelID, r.RoomCode)}
+		}
+		nights := make(map[string]int)
+		for j, period := range r.Nights {
+			// checkAllotment has already parsed the dates
+			from, _ := time.Parse(dateFmt, period.From)
+			to, _ := time.Parse(dateFmt, period.To)
+			for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+				night := d.Format(dateFmt)
+				if _, ok := nights[night]; ok {
+					return nil, &dataload.Error{File: file, Index: i, Field: fmt.Sprintf("nights[%d]", j), Msg: fmt.Sprintf("%s is already allotted", night)}
+				}
+				nights[night] = period.Rooms
+			}
+		}
+		rooms[key] = nights
+	}
+	return rooms, nil
+}
+
+// checkAllotment validates the values of an allotment record.
+func checkAllotment(r *allotmentRecord) error {
+	if err := dataload.Required("hotelId", r.HotelID); err != nil {
+		return err
+	}
+	if err := dataload.Required("roomCode", r.RoomCode); err != nil {
+		return err
+	}
+
+	for j, period := range r.Nights {
+		field := fmt.Sprintf("nights[%d]", j)
+		from, err := time.Parse(dateFmt, period.From)
+		if err != nil {
+			return dataload.Invalid(field+".from", "%q is not a YYYY-MM-DD date", period.From)
+		}
+		to, err := time.Parse(dateFmt, period.To)
+		if err != nil {
+			return dataload.Invalid(field+".to", "%q is not a YYYY-MM-DD date", period.To)
+		}
+		if !to.After(from) {
+			return dataload.Invalid(field+".to", "%s must be after from", period.To)
+		}
+		if period.Rooms < 0 {
+			return dataload.Invalid(field+".rooms", "%d must not be negative", period.Rooms)
+		}
+	}
+	return nil
+}
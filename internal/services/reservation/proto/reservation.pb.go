@@ -0,0 +1,525 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: internal/services/reservation/proto/reservation.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type HoldRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	HotelId string                 `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	// Room type code, as in rate.RoomType.code.
+	RoomCode string `protobuf:"bytes,2,opt,name=roomCode,proto3" json:"roomCode,omitempty"`
+	InDate   string `protobuf:"bytes,3,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate  string `protobuf:"bytes,4,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	// Number of rooms. Zero holds one.
+	Rooms         int32 `protobuf:"varint,5,opt,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldRequest) Reset() {
+	*x = HoldRequest{}
+	mi := &file_internal_services_reservation_proto_reservation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldRequest) ProtoMessage() {}
+
+func (x *HoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_reservation_proto_reservation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldRequest.ProtoReflect.Descriptor instead.
+func (*HoldRequest) Descriptor() ([]byte, []int) {
+	return file_internal_services_reservation_proto_reservation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HoldRequest) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *HoldRequest) GetRoomCode() string {
+	if x != nil {
+		return x.RoomCode
+	}
+	return ""
+}
+
+func (x *HoldRequest) GetInDate() string {
+	if x != nil {
+		return x.InDate
+	}
+	return ""
+}
+
+func (x *HoldRequest) GetOutDate() string {
+	if x != nil {
+		return x.OutDate
+	}
+	return ""
+}
+
+func (x *HoldRequest) GetRooms() int32 {
+	if x != nil {
+		return x.Rooms
+	}
+	return 0
+}
+
+type BookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingRequest) Reset() {
+	*x = BookingRequest{}
+	mi := &file_internal_services_reservation_proto_reservation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingRequest) ProtoMessage() {}
+
+func (x *BookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_reservation_proto_reservation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingRequest.ProtoReflect.Descriptor instead.
+func (*BookingRequest) Descriptor() ([]byte, []int) {
+	return file_internal_services_reservation_proto_reservation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Booking struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HotelId  string                 `protobuf:"bytes,2,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	RoomCode string                 `protobuf:"bytes,3,opt,name=roomCode,proto3" json:"roomCode,omitempty"`
+	InDate   string                 `protobuf:"bytes,4,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate  string                 `protobuf:"bytes,5,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	Rooms    int32                  `protobuf:"varint,6,opt,name=rooms,proto3" json:"rooms,omitempty"`
+	// "HELD", "CONFIRMED", "CANCELLED" or "EXPIRED".
+	Status string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// RFC 3339 times. expiresAt is only set while the booking is held.
+	CreatedAt     string `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ExpiresAt     string `protobuf:"bytes,9,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Booking) Reset() {
+	*x = Booking{}
+	mi := &file_internal_services_reservation_proto_reservation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Booking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Booking) ProtoMessage() {}
+
+func (x *Booking) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_reservation_proto_reservation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
+func (*Booking) Descriptor() ([]byte, []int) {
+	return file_internal_services_reservation_proto_reservation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Booking) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Booking) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *Booking) GetRoomCode() string {
+	if x != nil {
+		return x.RoomCode
+	}
+	return ""
+}
+
+func (x *Booking) GetInDate() string {
+	if x != nil {
+		return x.InDate
+	}
+	return ""
+}
+
+func (x *Booking) GetOutDate() string {
+	if x != nil {
+		return x.OutDate
+	}
+	return ""
+}
+
+func (x *Booking) GetRooms() int32 {
+	if x != nil {
+		return x.Rooms
+	}
+	return 0
+}
+
+func (x *Booking) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Booking) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Booking) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type AvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelIds      []string               `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
+	InDate        string                 `protobuf:"bytes,2,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate       string                 `protobuf:"bytes,3,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityRequest) Reset() {
+	*x = AvailabilityRequest{}
+	mi := &file_internal_services_reservation_proto_reservation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityRequest) ProtoMessage() {}
+
+func (x *AvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_reservation_proto_reservation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*AvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_services_reservation_proto_reservation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AvailabilityRequest) GetHotelIds() []string {
+	if x != nil {
+		return x.HotelIds
+	}
+	return nil
+}
+
+func (x *AvailabilityRequest) GetInDate() string {
+	if x != nil {
+		return x.InDate
+	}
+	return ""
+}
+
+func (x *AvailabilityRequest) GetOutDate() string {
+	if x != nil {
+		return x.OutDate
+	}
+	return ""
+}
+
+type AvailabilityResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Room types with tracked inventory. Room types without inventory are
+	// left out.
+	Rooms         []*RoomAvailability `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityResult) Reset() {
+	*x = AvailabilityResult{}
+	mi := &file_internal_services_reservation_proto_reservation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityResult) ProtoMessage() {}
+
+func (x *AvailabilityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_reservation_proto_reservation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityResult.ProtoReflect.Descriptor instead.
+func (*AvailabilityResult) Descriptor() ([]byte, []int) {
+	return file_internal_services_reservation_proto_reservation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AvailabilityResult) GetRooms() []*RoomAvailability {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+type RoomAvailability struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	HotelId  string                 `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	RoomCode string                 `protobuf:"bytes,2,opt,name=roomCode,proto3" json:"roomCode,omitempty"`
+	// The fewest rooms left on any night of the stay.
+	Available     int32 `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomAvailability) Reset() {
+	*x = RoomAvailability{}
+	mi := &file_internal_services_reservation_proto_reservation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomAvailability) ProtoMessage() {}
+
+func (x *RoomAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_reservation_proto_reservation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomAvailability.ProtoReflect.Descriptor instead.
+func (*RoomAvailability) Descriptor() ([]byte, []int) {
+	return file_internal_services_reservation_proto_reservation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RoomAvailability) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *RoomAvailability) GetRoomCode() string {
+	if x != nil {
+		return x.RoomCode
+	}
+	return ""
+}
+
+func (x *RoomAvailability) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+var File_internal_services_reservation_proto_reservation_proto protoreflect.FileDescriptor
+
+const file_internal_services_reservation_proto_reservation_proto_rawDesc = "" +
+	"\n" +
+	"5internal/services/reservation/proto/reservation.proto\x12\vreservation\"\x8b\x01\n" +
+	"\vHoldRequest\x12\x18\n" +
+	"\ahotelId\x18\x01 \x01(\tR\ahotelId\x12\x1a\n" +
+	"\broomCode\x18\x02 \x01(\tR\broomCode\x12\x16\n" +
+	"\x06inDate\x18\x03 \x01(\tR\x06inDate\x12\x18\n" +
+	"\aoutDate\x18\x04 \x01(\tR\aoutDate\x12\x14\n" +
+	"\x05rooms\x18\x05 \x01(\x05R\x05rooms\" \n" +
+	"\x0eBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xeb\x01\n" +
+	"\aBooking\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\ahotelId\x18\x02 \x01(\tR\ahotelId\x12\x1a\n" +
+	"\broomCode\x18\x03 \x01(\tR\broomCode\x12\x16\n" +
+	"\x06inDate\x18\x04 \x01(\tR\x06inDate\x12\x18\n" +
+	"\aoutDate\x18\x05 \x01(\tR\aoutDate\x12\x14\n" +
+	"\x05rooms\x18\x06 \x01(\x05R\x05rooms\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\tR\tcreatedAt\x12\x1c\n" +
+	"\texpiresAt\x18\t \x01(\tR\texpiresAt\"c\n" +
+	"\x13AvailabilityRequest\x12\x1a\n" +
+	"\bhotelIds\x18\x01 \x03(\tR\bhotelIds\x12\x16\n" +
+	"\x06inDate\x18\x02 \x01(\tR\x06inDate\x12\x18\n" +
+	"\aoutDate\x18\x03 \x01(\tR\aoutDate\"I\n" +
+	"\x12AvailabilityResult\x123\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x1d.reservation.RoomAvailabilityR\x05rooms\"f\n" +
+	"\x10RoomAvailability\x12\x18\n" +
+	"\ahotelId\x18\x01 \x01(\tR\ahotelId\x12\x1a\n" +
+	"\broomCode\x18\x02 \x01(\tR\broomCode\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x05R\tavailable2\xcd\x02\n" +
+	"\vReservation\x126\n" +
+	"\x04Hold\x12\x18.reservation.HoldRequest\x1a\x14.reservation.Booking\x12<\n" +
+	"\aConfirm\x12\x1b.reservation.BookingRequest\x1a\x14.reservation.Booking\x12;\n" +
+	"\x06Cancel\x12\x1b.reservation.BookingRequest\x1a\x14.reservation.Booking\x128\n" +
+	"\x03Get\x12\x1b.reservation.BookingRequest\x1a\x14.reservation.Booking\x12Q\n" +
+	"\fAvailability\x12 .reservation.AvailabilityRequest\x1a\x1f.reservation.AvailabilityResultBIZGgithub.com/harlow/go-micro-services/internal/services/reservation/protob\x06proto3"
+
+var (
+	file_internal_services_reservation_proto_reservation_proto_rawDescOnce sync.Once
+	file_internal_services_reservation_proto_reservation_proto_rawDescData []byte
+)
+
+func file_internal_services_reservation_proto_reservation_proto_rawDescGZIP() []byte {
+	file_internal_services_reservation_proto_reservation_proto_rawDescOnce.Do(func() {
+		file_internal_services_reservation_proto_reservation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_services_reservation_proto_reservation_proto_rawDesc), len(file_internal_services_reservation_proto_reservation_proto_rawDesc)))
+	})
+	return file_internal_services_reservation_proto_reservation_proto_rawDescData
+}
+
+var file_internal_services_reservation_proto_reservation_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_internal_services_reservation_proto_reservation_proto_goTypes = []any{
+	(*HoldRequest)(nil),         // 0: reservation.HoldRequest
+	(*BookingRequest)(nil),      // 1: reservation.BookingRequest
+	(*Booking)(nil),             // 2: reservation.Booking
+	(*AvailabilityRequest)(nil), // 3: reservation.AvailabilityRequest
+	(*AvailabilityResult)(nil),  // 4: reservation.AvailabilityResult
+	(*RoomAvailability)(nil),    // 5: reservation.RoomAvailability
+}
+var file_internal_services_reservation_proto_reservation_proto_depIdxs = []int32{
+	5, // 0: reservation.AvailabilityResult.rooms:type_name -> reservation.RoomAvailability
+	0, // 1: reservation.Reservation.Hold:input_type -> reservation.HoldRequest
+	1, // 2: reservation.Reservation.Confirm:input_type -> reservation.BookingRequest
+	1, // 3: reservation.Reservation.Cancel:input_type -> reservation.BookingRequest
+	1, // 4: reservation.Reservation.Get:input_type -> reservation.BookingRequest
+	3, // 5: reservation.Reservation.Availability:input_type -> reservation.AvailabilityRequest
+	2, // 6: reservation.Reservation.Hold:output_type -> reservation.Booking
+	2, // 7: reservation.Reservation.Confirm:output_type -> reservation.Booking
+	2, // 8: reservation.Reservation.Cancel:output_type -> reservation.Booking
+	2, // 9: reservation.Reservation.Get:output_type -> reservation.Booking
+	4, // 10: reservation.Reservation.Availability:output_type -> reservation.AvailabilityResult
+	6, // [6:11] is the sub-list for method output_type
+	1, // [1:6] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_internal_services_reservation_proto_reservation_proto_init() }
+func file_internal_services_reservation_proto_reservation_proto_init() {
+	if File_internal_services_reservation_proto_reservation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_services_reservation_proto_reservation_proto_rawDesc), len(file_internal_services_reservation_proto_reservation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_services_reservation_proto_reservation_proto_goTypes,
+		DependencyIndexes: file_internal_services_reservation_proto_reservation_proto_depIdxs,
+		MessageInfos:      file_internal_services_reservation_proto_reservation_proto_msgTypes,
+	}.Build()
+	File_internal_services_reservation_proto_reservation_proto = out.File
+	file_internal_services_reservation_proto_reservation_proto_goTypes = nil
+	file_internal_services_reservation_proto_reservation_proto_depIdxs = nil
+}
@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             v3.21.12
+// source: internal/services/reservation/proto/reservation.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Reservation_Hold_FullMethodName         = "/reservation.Reservation/Hold"
+	Reservation_Confirm_FullMethodName      = "/reservation.Reservation/Confirm"
+	Reservation_Cancel_FullMethodName       = "/reservation.Reservation/Cancel"
+	Reservation_Get_FullMethodName          = "/reservation.Reservation/Get"
+	Reservation_Availability_FullMethodName = "/reservation.Reservation/Availability"
+)
+
+// ReservationClient is the client API for Reservation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReservationClient interface {
+	// Holds rooms for a stay until the hold expires, taking them out of the
+	// inventory. Fails with FailedPrecondition when any night is sold out.
+	Hold(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*Booking, error)
+	// Turns a hold into a confirmed reservation. Confirming twice is a no-op.
+	Confirm(ctx context.Context, in *BookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	// Cancels a hold or a confirmed reservation and releases its rooms.
+	Cancel(ctx context.Context, in *BookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	// Looks up a booking by id.
+	Get(ctx context.Context, in *BookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	// Rooms left to sell for every night of a stay, per hotel and room type.
+	Availability(ctx context.Context, in *AvailabilityRequest, opts ...grpc.CallOption) (*AvailabilityResult, error)
+}
+
+type reservationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReservationClient(cc grpc.ClientConnInterface) ReservationClient {
+	return &reservationClient{cc}
+}
+
+func (c *reservationClient) Hold(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, Reservation_Hold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationClient) Confirm(ctx context.Context, in *BookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, Reservation_Confirm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationClient) Cancel(ctx context.Context, in *BookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, Reservation_Cancel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationClient) Get(ctx context.Context, in *BookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, Reservation_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationClient) Availability(ctx context.Context, in *AvailabilityRequest, opts ...grpc.CallOption) (*AvailabilityResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AvailabilityResult)
+	err := c.cc.Invoke(ctx, Reservation_Availability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReservationServer is the server API for Reservation service.
+// All implementations must embed UnimplementedReservationServer
+// for forward compatibility.
+type ReservationServer interface {
+	// Holds rooms for a stay until the hold expires, taking them out of the
+	// inventory. Fails with FailedPrecondition when any night is sold out.
+	Hold(context.Context, *HoldRequest) (*Booking, error)
+	// Turns a hold into a confirmed reservation. Confirming twice is a no-op.
+	Confirm(context.Context, *BookingRequest) (*Booking, error)
+	// Cancels a hold or a confirmed reservation and releases its rooms.
+	Cancel(context.Context, *BookingRequest) (*Booking, error)
+	// Looks up a booking by id.
+	Get(context.Context, *BookingRequest) (*Booking, error)
+	// Rooms left to sell for every night of a stay, per hotel and room type.
+	Availability(context.Context, *AvailabilityRequest) (*AvailabilityResult, error)
+	mustEmbedUnimplementedReservationServer()
+}
+
+// UnimplementedReservationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReservationServer struct{}
+
+func (UnimplementedReservationServer) Hold(context.Context, *HoldRequest) (*Booking, error) {
+	return nil, status.Error(codes.Unimplemented, "method Hold not implemented")
+}
+func (UnimplementedReservationServer) Confirm(context.Context, *BookingRequest) (*Booking, error) {
+	return nil, status.Error(codes.Unimplemented, "method Confirm not implemented")
+}
+func (UnimplementedReservationServer) Cancel(context.Context, *BookingRequest) (*Booking, error) {
+	return nil, status.Error(codes.Unimplemented, "method Cancel not implemented")
+}
+func (UnimplementedReservationServer) Get(context.Context, *BookingRequest) (*Booking, error) {
+	return nil, status.Error(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedReservationServer) Availability(context.Context, *AvailabilityRequest) (*AvailabilityResult, error) {
+	return nil, status.Error(codes.Unimplemented, "method Availability not implemented")
+}
+func (UnimplementedReservationServer) mustEmbedUnimplementedReservationServer() {}
+func (UnimplementedReservationServer) testEmbeddedByValue()                     {}
+
+// UnsafeReservationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReservationServer will
+// result in compilation errors.
+type UnsafeReservationServer interface {
+	mustEmbedUnimplementedReservationServer()
+}
+
+func RegisterReservationServer(s grpc.ServiceRegistrar, srv ReservationServer) {
+	// If the following call panics, it indicates UnimplementedReservationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Reservation_ServiceDesc, srv)
+}
+
+func _Reservation_Hold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).Hold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reservation_Hold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).Hold(ctx, req.(*HoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reservation_Confirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).Confirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reservation_Confirm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).Confirm(ctx, req.(*BookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reservation_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reservation_Cancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).Cancel(ctx, req.(*BookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reservation_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reservation_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).Get(ctx, req.(*BookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reservation_Availability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).Availability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Reservation_Availability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).Availability(ctx, req.(*AvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Reservation_ServiceDesc is the grpc.ServiceDesc for Reservation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Reservation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reservation.Reservation",
+	HandlerType: (*ReservationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Hold",
+			Handler:    _Reservation_Hold_Handler,
+		},
+		{
+			MethodName: "Confirm",
+			Handler:    _Reservation_Confirm_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _Reservation_Cancel_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Reservation_Get_Handler,
+		},
+		{
+			MethodName: "Availability",
+			Handler:    _Reservation_Availability_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/services/reservation/proto/reservation.proto",
+}
@@ -0,0 +1,408 @@
+package reservation
+
+import (
+	"container/heap"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/harlow/go-micro-services/internal/rpcerr"
+	runtime "github.com/harlow/go-micro-services/internal/runtime"
+	reservation "github.com/harlow/go-micro-services/internal/services/reservation/proto"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+const (
+	// maxStayNights matches the longest stay the rate service prices.
+	maxStayNights = 30
+	// maxRooms bounds the rooms a single booking can take.
+	maxRooms = 9
+	// bookingRetention is how long bookings can still be looked up once
+	// they are over: after being cancelled or expiring, or for confirmed
+	// ones after their check-out date.
+	bookingRetention = 7 * 24 * time.Hour
+)
+
+// Booking states.
+const (
+	statusHeld      = "HELD"
+	statusConfirmed = "CONFIRMED"
+	statusCancelled = "CANCELLED"
+	statusExpired   = "EXPIRED"
+)
+
+// New returns a new server. Holds that are not confirmed within holdTTL
+// expire and give their rooms back.
+func New(holdTTL time.Duration) *Reservation {
+	return newReservation(loadAllotments("data/allotments.json"), holdTTL)
+}
+
+func newReservation(allotments map[roomKey]map[string]int, holdTTL time.Duration) *Reservation {
+	s := &Reservation{
+		holdTTL:  holdTTL,
+		now:      time.Now,
+		rooms:    allotments,
+		taken:    make(map[roomKey]map[string]int),
+		bookings: make(map[string]*booking),
+		holds:    make(map[string]*booking),
+		byHotel:  make(map[string][]string),
+	}
+	for key := range allotments {
+		s.byHotel[key.hotelID] = append(s.byHotel[key.hotelID], key.roomCode)
+	}
+	return s
+}
+
+// Reservation implements the reservation service. Bookings live in memory,
+// are forgotten bookingRetention after they are over and are lost on
+// restart.
+type Reservation struct {
+	reservation.UnimplementedReservationServer
+	holdTTL time.Duration
+	now     func() time.Time
+
+	// mu guards everything below, so checking and taking rooms for a
+	// booking happen atomically
+	mu sync.Mutex
+	// rooms for sale and rooms held or confirmed, per night
+	rooms    map[roomKey]map[string]int
+	taken    map[roomKey]map[string]int
+	bookings map[string]*booking
+	// bookings currently held, checked for expiry on every call
+	holds map[string]*booking
+	// bookings that are over, soonest forgotten first
+	over overHeap
+	// room codes with inventory, per hotel
+	byHotel map[string][]string
+}
+
+// roomKey identifies a room type of a hotel.
+type roomKey struct {
+	hotelID  string
+	roomCode string
+}
+
+type booking struct {
+	id        string
+	room      roomKey
+	in, out   time.Time
+	rooms     int
+	status    string
+	createdAt time.Time
+	expiresAt time.Time
+	// when the booking is forgotten once it is over, and its position in
+	// Reservation.over, -1 while it is not there
+	forgetAt  time.Time
+	overIndex int
+}
+
+// Run starts the server
+func (s *Reservation) Run(port int) error {
+	srv := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(runtime.RejectWhileDraining),
+	)
+	reservation.RegisterReservationServer(srv, s)
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+
+	return runtime.ServeGRPCGracefully(lis, srv)
+}
+
+// Hold takes rooms out of the inventory for every night of the stay.
+func (s *Reservation) Hold(ctx context.Context, req *reservation.HoldRequest) (*reservation.Booking, error) {
+	if strings.TrimSpace(req.HotelId) == "" {
+		return nil, rpcerr.InvalidArgument("hotelId", "must not be empty")
+	}
+	if strings.TrimSpace(req.RoomCode) == "" {
+		return nil, rpcerr.InvalidArgument("roomCode", "must not be empty")
+	}
+	in, out, err := validateStay(req.InDate, req.OutDate)
+	if err != nil {
+		return nil, err
+	}
+	rooms := int(req.Rooms)
+	if rooms == 0 {
+		rooms = 1
+	}
+	if rooms < 0 || rooms > maxRooms {
+		return nil, rpcerr.InvalidArgument("rooms", fmt.Sprintf("must be between 1 and %d", maxRooms))
+	}
+
+	key := roomKey{hotelID: req.HotelId, roomCode: req.RoomCode}
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.advance(now)
+
+	if _, ok := s.rooms[key]; !ok {
+		return nil, rpcerr.NotFound("room", req.HotelId+"/"+req.RoomCode, fmt.Sprintf("hotel %q has no room type %q", req.HotelId, req.RoomCode))
+	}
+	for d := in; d.Before(out); d = d.AddDate(0, 0, 1) {
+		night := d.Format(dateFmt)
+		if left := s.left(key, night); left < rooms {
+			subject := fmt.Sprintf("%s/%s/%s", req.HotelId, req.RoomCode, night)
+			if left == 0 {
+				return nil, rpcerr.FailedPrecondition("SOLD_OUT", subject, fmt.Sprintf("sold out on %s", night))
+			}
+			return nil, rpcerr.FailedPrecondition("SOLD_OUT", subject, fmt.Sprintf("only %d rooms left on %s", left, night))
+		}
+	}
+
+	b := &booking{
+		id:        id,
+		room:      key,
+		in:        in,
+		out:       out,
+		rooms:     rooms,
+		status:    statusHeld,
+		createdAt: now,
+		expiresAt: now.Add(s.holdTTL),
+		overIndex: -1,
+	}
+	s.take(b, rooms)
+	s.bookings[id] = b
+	s.holds[id] = b
+	return b.proto(), nil
+}
+
+// Confirm turns a hold into a confirmed reservation.
+func (s *Reservation) Confirm(ctx context.Context, req *reservation.BookingRequest) (*reservation.Booking, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.advance(now)
+
+	b, err := s.find(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	switch b.status {
+	case statusHeld:
+		b.status = statusConfirmed
+		b.expiresAt = time.Time{}
+		delete(s.holds, b.id)
+		// a stay that is already over is still kept from now on
+		over := b.out
+		if now.After(over) {
+			over = now
+		}
+		s.retire(b, over.Add(bookingRetention))
+	case statusExpired:
+		return nil, rpcerr.FailedPrecondition("HOLD_EXPIRED", b.id, "hold expired before it was confirmed")
+	case statusCancelled:
+		return nil, rpcerr.FailedPrecondition("CANCELLED", b.id, "booking was cancelled")
+	}
+	return b.proto(), nil
+}
+
+// Cancel cancels a hold or a confirmed reservation and gives its rooms
+// back. Bookings that are already cancelled or expired are returned as is.
+func (s *Reservation) Cancel(ctx context.Context, req *reservation.BookingRequest) (*reservation.Booking, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.advance(now)
+
+	b, err := s.find(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if b.status == statusHeld || b.status == statusConfirmed {
+		s.take(b, -b.rooms)
+		b.status = statusCancelled
+		b.expiresAt = time.Time{}
+		delete(s.holds, b.id)
+		s.retire(b, now.Add(bookingRetention))
+	}
+	return b.proto(), nil
+}
+
+// Get returns a booking as it currently stands.
+func (s *Reservation) Get(ctx context.Context, req *reservation.BookingRequest) (*reservation.Booking, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advance(s.now())
+
+	b, err := s.find(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return b.proto(), nil
+}
+
+// Availability returns the rooms left for every night of a stay.
+func (s *Reservation) Availability(ctx context.Context, req *reservation.AvailabilityRequest) (*reservation.AvailabilityResult, error) {
+	in, out, err := validateStay(req.InDate, req.OutDate)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advance(s.now())
+
+	res := new(reservation.AvailabilityResult)
+	for _, hotelID := range req.HotelIds {
+		for _, code := range s.byHotel[hotelID] {
+			key := roomKey{hotelID: hotelID, roomCode: code}
+			available := -1
+			for d := in; d.Before(out); d = d.AddDate(0, 0, 1) {
+				if left := s.left(key, d.Format(dateFmt)); available < 0 || left < available {
+					available = left
+				}
+			}
+			res.Rooms = append(res.Rooms, &reservation.RoomAvailability{
+				HotelId:   hotelID,
+				RoomCode:  code,
+				Available: int32(available),
+			})
+		}
+	}
+	return res, nil
+}
+
+func (s *Reservation) find(id string) (*booking, error) {
+	b, ok := s.bookings[id]
+	if !ok {
+		return nil, rpcerr.NotFound("reservation", id, fmt.Sprintf("reservation %q not found", id))
+	}
+	return b, nil
+}
+
+// left returns the rooms still for sale on a night. Nights without
+// inventory have none.
+func (s *Reservation) left(key roomKey, night string) int {
+	return s.rooms[key][night] - s.taken[key][night]
+}
+
+// take adds n rooms, or gives them back when negative, to every night of
+// the booking.
+func (s *Reservation) take(b *booking, n int) {
+	nights := s.taken[b.room]
+	if nights == nil {
+		nights = make(map[string]int)
+		s.taken[b.room] = nights
+	}
+	for d := b.in; d.Before(b.out); d = d.AddDate(0, 0, 1) {
+		nights[d.Format(dateFmt)] += n
+	}
+}
+
+// advance expires the holds and forgets the bookings due by now.
+func (s *Reservation) advance(now time.Time) {
+	s.expireHolds(now)
+	for len(s.over) > 0 && !now.Before(s.over[0].forgetAt) {
+		b := heap.Pop(&s.over).(*booking)
+		delete(s.bookings, b.id)
+	}
+}
+
+// expireHolds gives back the rooms of holds past their expiry.
+func (s *Reservation) expireHolds(now time.Time) {
+	for id, b := range s.holds {
+		if now.Before(b.expiresAt) {
+			continue
+		}
+		s.take(b, -b.rooms)
+		b.status = statusExpired
+		delete(s.holds, id)
+		s.retire(b, b.expiresAt.Add(bookingRetention))
+	}
+}
+
+// retire schedules a booking that is over to be forgotten at forgetAt,
+// rescheduling it if it already was.
+func (s *Reservation) retire(b *booking, forgetAt time.Time) {
+	b.forgetAt = forgetAt
+	if b.overIndex >= 0 {
+		heap.Fix(&s.over, b.overIndex)
+		return
+	}
+	heap.Push(&s.over, b)
+}
+
+// overHeap orders bookings by when they are forgotten.
+type overHeap []*booking
+
+func (h overHeap) Len() int           { return len(h) }
+func (h overHeap) Less(i, j int) bool { return h[i].forgetAt.Before(h[j].forgetAt) }
+
+func (h overHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].overIndex, h[j].overIndex = i, j
+}
+
+func (h *overHeap) Push(x interface{}) {
+	b := x.(*booking)
+	b.overIndex = len(*h)
+	*h = append(*h, b)
+}
+
+func (h *overHeap) Pop() interface{} {
+	old := *h
+	b := old[len(old)-1]
+	old[len(old)-1] = nil
+	b.overIndex = -1
+	*h = old[:len(old)-1]
+	return b
+}
+
+func (b *booking) proto() *reservation.Booking {
+	res := &reservation.Booking{
+		Id:        b.id,
+		HotelId:   b.room.hotelID,
+		RoomCode:  b.room.roomCode,
+		InDate:    b.in.Format(dateFmt),
+		OutDate:   b.out.Format(dateFmt),
+		Rooms:     int32(b.rooms),
+		Status:    b.status,
+		CreatedAt: b.createdAt.UTC().Format(time.RFC3339),
+	}
+	if b.status == statusHeld {
+		res.ExpiresAt = b.expiresAt.UTC().Format(time.RFC3339)
+	}
+	return res
+}
+
+// validateStay checks the stay dates are well formed, in order and no
+// longer than maxStayNights.
+func validateStay(inDate, outDate string) (time.Time, time.Time, error) {
+	in, err := time.Parse(dateFmt, inDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, rpcerr.InvalidArgument("inDate", "expected YYYY-MM-DD")
+	}
+	out, err := time.Parse(dateFmt, outDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, rpcerr.InvalidArgument("outDate", "expected YYYY-MM-DD")
+	}
+	if !out.After(in) {
+		return time.Time{}, time.Time{}, rpcerr.InvalidArgument("outDate", "must be after inDate")
+	}
+	if out.After(in.AddDate(0, 0, maxStayNights)) {
+		return time.Time{}, time.Time{}, rpcerr.InvalidArgument("outDate", fmt.Sprintf("stays are limited to %d nights", maxStayNights))
+	}
+	return in, out, nil
+}
+
+func newID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate reservation id: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,293 @@
+package reservation
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/harlow/go-micro-services/data"
+	"github.com/harlow/go-micro-services/internal/rpcerr"
+	reservationpb "github.com/harlow/go-micro-services/internal/services/reservation/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var king = roomKey{hotelID: "1", roomCode: "KNG"}
+
+// testReservation returns a server selling two king rooms of hotel 1 on
+// the nights of 2015-04-09 to 2015-04-11, with a clock tests can move.
+func testReservation(now *time.Time) *Reservation {
+	s := newReservation(map[roomKey]map[string]int{
+		king: {"2015-04-09": 2, "2015-04-10": 2, "2015-04-11": 2},
+	}, 15*time.Minute)
+	s.now = func() time.Time { return *now }
+	return s
+}
+
+func hold(t *testing.T, s *Reservation, in, out string, rooms int32) (*reservationpb.Booking, error) {
+	t.Helper()
+	return s.Hold(context.Background(), &reservationpb.HoldRequest{
+		HotelId:  king.hotelID,
+		RoomCode: king.roomCode,
+		InDate:   in,
+		OutDate:  out,
+		Rooms:    rooms,
+	})
+}
+
+func available(t *testing.T, s *Reservation, in, out string) int32 {
+	t.Helper()
+	res, err := s.Availability(context.Background(), &reservationpb.AvailabilityRequest{
+		HotelIds: []string{king.hotelID},
+		InDate:   in,
+		OutDate:  out,
+	})
+	if err != nil {
+		t.Fatalf("Availability returned error: %v", err)
+	}
+	if len(res.Rooms) != 1 {
+		t.Fatalf("expected 1 room type, got %d", len(res.Rooms))
+	}
+	return res.Rooms[0].Available
+}
+
+func TestHoldTakesRoomsForEveryNight(t *testing.T) {
+	now := time.Date(2015, 4, 1, 12, 0, 0, 0, time.UTC)
+	s := testReservation(&now)
+
+	b, err := hold(t, s, "2015-04-09", "2015-04-11", 0)
+	if err != nil {
+		t.Fatalf("Hold returned error: %v", err)
+	}
+	if b.Status != statusHeld || b.Rooms != 1 || b.Id == "" {
+		t.Fatalf("unexpected booking: %+v", b)
+	}
+	if b.ExpiresAt != "2015-04-01T12:15:00Z" {
+		t.Fatalf("expected hold to expire at 12:15, got %q", b.ExpiresAt)
+	}
+
+	if got := available(t, s, "2015-04-09", "2015-04-11"); got != 1 {
+		t.Fatalf("expected 1 room left during the stay, got %d", got)
+	}
+	if got := available(t, s, "2015-04-11", "2015-04-12"); got != 2 {
+		t.Fatalf("expected the night of departure to be untouched, got %d", got)
+	}
+	// nights without inventory have no rooms
+	if got := available(t, s, "2015-04-11", "2015-04-13"); got != 0 {
+		t.Fatalf("expected no rooms past the allotment, got %d", got)
+	}
+}
+
+func TestHoldRefusesToOversell(t *testing.T) {
+	now := time.Date(2015, 4, 1, 12, 0, 0, 0, time.UTC)
+	s := testReservation(&now)
+
+	if _, err := hold(t, s, "2015-04-10", "2015-04-11", 2); err != nil {
+		t.Fatalf("Hold returned error: %v", err)
+	}
+	_, err := hold(t, s, "2015-04-09", "2015-04-11", 1)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+	violations := rpcerr.PreconditionViolations(err)
+	if len(violations) != 1 || violations[0].Type != "SOLD_OUT" || violations[0].Subject != "1/KNG/2015-04-10" {
+		t.Fatalf("unexpected violations: %v", violations)
+	}
+
+	// the failed hold takes nothing
+	if got := available(t, s, "2015-04-09", "2015-04-10"); got != 2 {
+		t.Fatalf("expected 2 rooms left on 2015-04-09, got %d", got)
+	}
+}
+
+func TestHoldValidatesRequest(t *testing.T) {
+	now := time.Date(2015, 4, 1, 12, 0, 0, 0, time.UTC)
+	s := testReservation(&now)
+
+	for _, tc := range []struct {
+		name string
+		req  *reservationpb.HoldRequest
+		code codes.Code
+	}{
+		{"missing room", &reservationpb.HoldRequest{HotelId: "1", InDate: "2015-04-09", OutDate: "2015-04-10"}, codes.InvalidArgument},
+		{"bad dates", &reservationpb.HoldRequest{HotelId: "1", RoomCode: "KNG", InDate: "2015-04-10", OutDate: "2015-04-09"}, codes.InvalidArgument},
+		{"too many rooms", &reservationpb.HoldRequest{HotelId: "1", RoomCode: "KNG", InDate: "2015-04-09", OutDate: "2015-04-10", Rooms: 10}, codes.InvalidArgument},
+		{"unknown room", &reservationpb.HoldRequest{HotelId: "1", RoomCode: "QN", InDate: "2015-04-09", OutDate: "2015-04-10"}, codes.NotFound},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := s.Hold(context.Background(), tc.req); status.Code(err) != tc.code {
+				t.Fatalf("expected %v, got %v", tc.code, err)
+			}
+		})
+	}
+}
+
+func TestConcurrentHoldsDoNotOversell(t *testing.T) {
+	now := time.Date(2015, 4, 1, 12, 0, 0, 0, time.UTC)
+	s := testReservation(&now)
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		held int
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := hold(t, s, "2015-04-09", "2015-04-12", 1); err == nil {
+				mu.Lock()
+				held++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if held != 2 {
+		t.Fatalf("expected exactly 2 holds to succeed, got %d", held)
+	}
+	if got := available(t, s, "2015-04-09", "2015-04-12"); got != 0 {
+		t.Fatalf("expected the room to be sold out, got %d left", got)
+	}
+}
+
+func TestHoldsExpire(t *testing.T) {
+	now := time.Date(2015, 4, 1, 12, 0, 0, 0, time.UTC)
+	s := testReservation(&now)
+
+	b, err := hold(t, s, "2015-04-09", "2015-04-10", 2)
+	if err != nil {
+		t.Fatalf("Hold returned error: %v", err)
+	}
+
+	now = now.Add(15 * time.Minute)
+	if got := available(t, s, "2015-04-09", "2015-04-10"); got != 2 {
+		t.Fatalf("expected expired hold to give its rooms back, got %d left", got)
+	}
+	if got, err := s.Get(context.Background(), &reservationpb.BookingRequest{Id: b.Id}); err != nil || got.Status != statusExpired {
+		t.Fatalf("expected the booking to be expired, got %v, %v", got, err)
+	}
+	_, err = s.Confirm(context.Background(), &reservationpb.BookingRequest{Id: b.Id})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition confirming an expired hold, got %v", err)
+	}
+	if v := rpcerr.PreconditionViolations(err); len(v) != 1 || v[0].Type != "HOLD_EXPIRED" {
+		t.Fatalf("unexpected violations: %v", v)
+	}
+}
+
+func TestConfirmAndCancel(t *testing.T) {
+	now := time.Date(2015, 4, 1, 12, 0, 0, 0, time.UTC)
+	s := testReservation(&now)
+	ctx := context.Background()
+
+	b, err := hold(t, s, "2015-04-09", "2015-04-11", 1)
+	if err != nil {
+		t.Fatalf("Hold returned error: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		confirmed, err := s.Confirm(ctx, &reservationpb.BookingRequest{Id: b.Id})
+		if err != nil {
+			t.Fatalf("Confirm returned error: %v", err)
+		}
+		if confirmed.Status != statusConfirmed || confirmed.ExpiresAt != "" {
+			t.Fatalf("unexpected booking: %+v", confirmed)
+		}
+	}
+
+	// confirmed reservations do not expire
+	now = now.Add(time.Hour)
+	if got := available(t, s, "2015-04-09", "2015-04-11"); got != 1 {
+		t.Fatalf("expected 1 room left, got %d", got)
+	}
+
+	for i := 0; i < 2; i++ {
+		cancelled, err := s.Cancel(ctx, &reservationpb.BookingRequest{Id: b.Id})
+		if err != nil {
+			t.Fatalf("Cancel returned error: %v", err)
+		}
+		if cancelled.Status != statusCancelled {
+			t.Fatalf("unexpected booking: %+v", cancelled)
+		}
+	}
+	if got := available(t, s, "2015-04-09", "2015-04-11"); got != 2 {
+		t.Fatalf("expected cancelling to give the room back, got %d left", got)
+	}
+
+	if _, err := s.Confirm(ctx, &reservationpb.BookingRequest{Id: b.Id}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition confirming a cancelled booking, got %v", err)
+	}
+	if _, err := s.Cancel(ctx, &reservationpb.BookingRequest{Id: "missing"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+}
+
+func TestBookingsAreForgottenWhenOver(t *testing.T) {
+	now := time.Date(2015, 4, 1, 12, 0, 0, 0, time.UTC)
+	s := testReservation(&now)
+	ctx := context.Background()
+	get := func(id string) error {
+		_, err := s.Get(ctx, &reservationpb.BookingRequest{Id: id})
+		return err
+	}
+
+	confirmed, _ := hold(t, s, "2015-04-09", "2015-04-10", 1)
+	if _, err := s.Confirm(ctx, &reservationpb.BookingRequest{Id: confirmed.Id}); err != nil {
+		t.Fatalf("Confirm returned error: %v", err)
+	}
+	cancelled, _ := hold(t, s, "2015-04-10", "2015-04-11", 1)
+	if _, err := s.Cancel(ctx, &reservationpb.BookingRequest{Id: cancelled.Id}); err != nil {
+		t.Fatalf("Cancel returned error: %v", err)
+	}
+	expired, _ := hold(t, s, "2015-04-10", "2015-04-11", 1)
+
+	// cancelled and expired bookings are kept for bookingRetention
+	now = now.Add(bookingRetention - time.Minute)
+	for _, id := range []string{confirmed.Id, cancelled.Id, expired.Id} {
+		if err := get(id); err != nil {
+			t.Fatalf("Get(%s) returned error: %v", id, err)
+		}
+	}
+	now = now.Add(time.Minute)
+	if err := get(cancelled.Id); status.Code(err) != codes.NotFound {
+		t.Fatalf("Get(cancelled) error = %v, want NotFound", err)
+	}
+	if err := get(expired.Id); err != nil {
+		t.Fatalf("expired hold forgotten before bookingRetention: %v", err)
+	}
+	now = now.Add(15 * time.Minute)
+	if err := get(expired.Id); status.Code(err) != codes.NotFound {
+		t.Fatalf("Get(expired) error = %v, want NotFound", err)
+	}
+
+	// confirmed ones until bookingRetention after check-out
+	now = time.Date(2015, 4, 10, 0, 0, 0, 0, time.UTC).Add(bookingRetention)
+	if err := get(confirmed.Id); status.Code(err) != codes.NotFound {
+		t.Fatalf("Get(confirmed) error = %v, want NotFound", err)
+	}
+	if len(s.bookings) != 0 || len(s.over) != 0 {
+		t.Fatalf("%d bookings and %d over left, want none", len(s.bookings), len(s.over))
+	}
+}
+
+func TestParseAllotments(t *testing.T) {
+	rooms, err := parseAllotments("data/allotments.json", data.MustAsset("data/allotments.json"))
+	if err != nil {
+		t.Fatalf("embedded allotments do not parse: %v", err)
+	}
+	if len(rooms[king]) != 30 {
+		t.Fatalf("expected 30 nights for hotel 1 kings, got %d", len(rooms[king]))
+	}
+
+	_, err = parseAllotments("allotments.json", []byte(`[
+		{"hotelId":"1","roomCode":"KNG","nights":[
+			{"from":"2015-04-01","to":"2015-04-10","rooms":2},
+			{"from":"2015-04-09","to":"2015-04-12","rooms":1}
+		]}
+	]`))
+	if err == nil || err.Error() != "allotments.json[0].nights[1]: 2015-04-09 is already allotted" {
+		t.Fatalf("expected overlapping periods to be rejected, got %v", err)
+	}
+}
@@ -1,20 +1,19 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.26.0
-// 	protoc        v3.15.8
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
 // source: internal/services/search/proto/search.proto
 
 package proto
 
 import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
+	proto "github.com/harlow/go-micro-services/internal/services/geo/proto"
+	proto1 "github.com/harlow/go-micro-services/internal/services/rate/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -25,23 +24,51 @@ const (
 )
 
 type NearbyRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: single precision location, read only when latitude and
+	// longitude are unset.
+	//
+	// Deprecated: Marked as deprecated in internal/services/search/proto/search.proto.
+	Lat float32 `protobuf:"fixed32,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	// Deprecated: Marked as deprecated in internal/services/search/proto/search.proto.
+	Lon      float32 `protobuf:"fixed32,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	InDate   string  `protobuf:"bytes,3,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate  string  `protobuf:"bytes,4,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	RadiusKm float32 `protobuf:"fixed32,5,opt,name=radiusKm,proto3" json:"radiusKm,omitempty"`
+	// Ranker used to order the hotels: "distance" (default), "price",
+	// "rating" or "weighted".
+	Sort string `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	// Sort order: "asc" or "desc". Defaults to "desc" for rating and weighted
+	// and "asc" otherwise, which always puts the best scores first.
+	Order string `protobuf:"bytes,7,opt,name=order,proto3" json:"order,omitempty"`
+	// Page size. Zero uses the server default.
+	Limit int32 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Opaque cursor from a previous SearchResult.nextCursor.
+	Cursor string `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Blend used by the "weighted" ranker. Unset uses the server defaults.
+	Weights *Weights `protobuf:"bytes,10,opt,name=weights,proto3" json:"weights,omitempty"`
+	// When set, searches the nearest hotels whatever their distance instead
+	// of the hotels within radiusKm.
+	Nearest int32 `protobuf:"varint,11,opt,name=nearest,proto3" json:"nearest,omitempty"`
+	// Double precision location, taking precedence over lat/lon when set.
+	Latitude  *float64        `protobuf:"fixed64,12,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude *float64        `protobuf:"fixed64,13,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	RatePlans *RatePlanFilter `protobuf:"bytes,14,opt,name=ratePlans,proto3" json:"ratePlans,omitempty"`
+	// ISO 4217 code of the currency to quote rates in. Empty keeps the
+	// currency of each plan.
+	Currency string `protobuf:"bytes,15,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Only return hotels offering every one of these amenities, e.g. "wifi"
+	// and "parking". See profile.Hotel for the amenities hotels list.
+	Amenities     []string `protobuf:"bytes,16,rep,name=amenities,proto3" json:"amenities,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Lat     float32 `protobuf:"fixed32,1,opt,name=lat,proto3" json:"lat,omitempty"`
-	Lon     float32 `protobuf:"fixed32,2,opt,name=lon,proto3" json:"lon,omitempty"`
-	InDate  string  `protobuf:"bytes,3,opt,name=inDate,proto3" json:"inDate,omitempty"`
-	OutDate string  `protobuf:"bytes,4,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NearbyRequest) Reset() {
 	*x = NearbyRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_services_search_proto_search_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_internal_services_search_proto_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *NearbyRequest) String() string {
@@ -52,7 +79,7 @@ func (*NearbyRequest) ProtoMessage() {}
 
 func (x *NearbyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_services_search_proto_search_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -67,6 +94,7 @@ func (*NearbyRequest) Descriptor() ([]byte, []int) {
 	return file_internal_services_search_proto_search_proto_rawDescGZIP(), []int{0}
 }
 
+// Deprecated: Marked as deprecated in internal/services/search/proto/search.proto.
 func (x *NearbyRequest) GetLat() float32 {
 	if x != nil {
 		return x.Lat
@@ -74,6 +102,7 @@ func (x *NearbyRequest) GetLat() float32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in internal/services/search/proto/search.proto.
 func (x *NearbyRequest) GetLon() float32 {
 	if x != nil {
 		return x.Lon
@@ -95,21 +124,502 @@ func (x *NearbyRequest) GetOutDate() string {
 	return ""
 }
 
-type SearchResult struct {
-	state         protoimpl.MessageState
+func (x *NearbyRequest) GetRadiusKm() float32 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *NearbyRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *NearbyRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *NearbyRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *NearbyRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *NearbyRequest) GetWeights() *Weights {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *NearbyRequest) GetNearest() int32 {
+	if x != nil {
+		return x.Nearest
+	}
+	return 0
+}
+
+func (x *NearbyRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *NearbyRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *NearbyRequest) GetRatePlans() *RatePlanFilter {
+	if x != nil {
+		return x.RatePlans
+	}
+	return nil
+}
+
+func (x *NearbyRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *NearbyRequest) GetAmenities() []string {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+type CityRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	City    string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	InDate  string                 `protobuf:"bytes,2,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate string                 `protobuf:"bytes,3,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	// Ranker: "price", "rating" or "weighted". Without one, hotels keep the
+	// city order.
+	Sort          string          `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Order         string          `protobuf:"bytes,5,opt,name=order,proto3" json:"order,omitempty"`
+	Limit         int32           `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string          `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Weights       *Weights        `protobuf:"bytes,8,opt,name=weights,proto3" json:"weights,omitempty"`
+	RatePlans     *RatePlanFilter `protobuf:"bytes,9,opt,name=ratePlans,proto3" json:"ratePlans,omitempty"`
+	Currency      string          `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amenities     []string        `protobuf:"bytes,11,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CityRequest) Reset() {
+	*x = CityRequest{}
+	mi := &file_internal_services_search_proto_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CityRequest) ProtoMessage() {}
+
+func (x *CityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_search_proto_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CityRequest.ProtoReflect.Descriptor instead.
+func (*CityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_services_search_proto_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CityRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CityRequest) GetInDate() string {
+	if x != nil {
+		return x.InDate
+	}
+	return ""
+}
+
+func (x *CityRequest) GetOutDate() string {
+	if x != nil {
+		return x.OutDate
+	}
+	return ""
+}
+
+func (x *CityRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *CityRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *CityRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CityRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *CityRequest) GetWeights() *Weights {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *CityRequest) GetRatePlans() *RatePlanFilter {
+	if x != nil {
+		return x.RatePlans
+	}
+	return nil
+}
+
+func (x *CityRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CityRequest) GetAmenities() []string {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+// Searches the hotels inside a map viewport. The "distance" ranker measures
+// from the center of the viewport.
+type WithinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NorthEast     *proto.Location        `protobuf:"bytes,1,opt,name=northEast,proto3" json:"northEast,omitempty"`
+	SouthWest     *proto.Location        `protobuf:"bytes,2,opt,name=southWest,proto3" json:"southWest,omitempty"`
+	InDate        string                 `protobuf:"bytes,3,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate       string                 `protobuf:"bytes,4,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	Sort          string                 `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
+	Order         string                 `protobuf:"bytes,6,opt,name=order,proto3" json:"order,omitempty"`
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string                 `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Weights       *Weights               `protobuf:"bytes,9,opt,name=weights,proto3" json:"weights,omitempty"`
+	RatePlans     *RatePlanFilter        `protobuf:"bytes,10,opt,name=ratePlans,proto3" json:"ratePlans,omitempty"`
+	Currency      string                 `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amenities     []string               `protobuf:"bytes,12,rep,name=amenities,proto3" json:"amenities,omitempty"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-	HotelIds []string `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
+func (x *WithinRequest) Reset() {
+	*x = WithinRequest{}
+	mi := &file_internal_services_search_proto_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchResult) Reset() {
-	*x = SearchResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_services_search_proto_search_proto_msgTypes[1]
+func (x *WithinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithinRequest) ProtoMessage() {}
+
+func (x *WithinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_search_proto_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithinRequest.ProtoReflect.Descriptor instead.
+func (*WithinRequest) Descriptor() ([]byte, []int) {
+	return file_internal_services_search_proto_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WithinRequest) GetNorthEast() *proto.Location {
+	if x != nil {
+		return x.NorthEast
+	}
+	return nil
+}
+
+func (x *WithinRequest) GetSouthWest() *proto.Location {
+	if x != nil {
+		return x.SouthWest
+	}
+	return nil
+}
+
+func (x *WithinRequest) GetInDate() string {
+	if x != nil {
+		return x.InDate
+	}
+	return ""
+}
+
+func (x *WithinRequest) GetOutDate() string {
+	if x != nil {
+		return x.OutDate
+	}
+	return ""
+}
+
+func (x *WithinRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *WithinRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
+func (x *WithinRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *WithinRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *WithinRequest) GetWeights() *Weights {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *WithinRequest) GetRatePlans() *RatePlanFilter {
+	if x != nil {
+		return x.RatePlans
+	}
+	return nil
+}
+
+func (x *WithinRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *WithinRequest) GetAmenities() []string {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+// Relative weights of each signal in the "weighted" ranker.
+type Weights struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Distance      float64                `protobuf:"fixed64,1,opt,name=distance,proto3" json:"distance,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Rating        float64                `protobuf:"fixed64,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Weights) Reset() {
+	*x = Weights{}
+	mi := &file_internal_services_search_proto_search_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Weights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Weights) ProtoMessage() {}
+
+func (x *Weights) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_search_proto_search_proto_msgTypes[3]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Weights.ProtoReflect.Descriptor instead.
+func (*Weights) Descriptor() ([]byte, []int) {
+	return file_internal_services_search_proto_search_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Weights) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
 	}
+	return 0
+}
+
+func (x *Weights) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Weights) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+// Selects the rate plans returned for each hotel. By default only the
+// cheapest matching plan is kept.
+type RatePlanFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only consider plans with one of these rate codes, when set.
+	RateCodes []string `protobuf:"bytes,1,rep,name=rateCodes,proto3" json:"rateCodes,omitempty"`
+	// Only consider plans for one of these room type codes, when set.
+	RoomCodes []string `protobuf:"bytes,2,rep,name=roomCodes,proto3" json:"roomCodes,omitempty"`
+	// Return every matching plan instead of the cheapest one.
+	All           bool `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatePlanFilter) Reset() {
+	*x = RatePlanFilter{}
+	mi := &file_internal_services_search_proto_search_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatePlanFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatePlanFilter) ProtoMessage() {}
+
+func (x *RatePlanFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_search_proto_search_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatePlanFilter.ProtoReflect.Descriptor instead.
+func (*RatePlanFilter) Descriptor() ([]byte, []int) {
+	return file_internal_services_search_proto_search_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RatePlanFilter) GetRateCodes() []string {
+	if x != nil {
+		return x.RateCodes
+	}
+	return nil
+}
+
+func (x *RatePlanFilter) GetRoomCodes() []string {
+	if x != nil {
+		return x.RoomCodes
+	}
+	return nil
+}
+
+func (x *RatePlanFilter) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type SearchResult struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	HotelIds []string               `protobuf:"bytes,1,rep,name=hotelIds,proto3" json:"hotelIds,omitempty"`
+	// The matched hotels, in the same order as hotelIds, along with their
+	// rate plans for the requested stay.
+	Hotels []*Hotel `protobuf:"bytes,2,rep,name=hotels,proto3" json:"hotels,omitempty"`
+	// Cursor for the next page, empty when there are no more hotels. It is
+	// tied to the request: only limit may change on the next one.
+	NextCursor string `protobuf:"bytes,3,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	// Name of the ranker that ordered the hotels.
+	Ranker string `protobuf:"bytes,4,opt,name=ranker,proto3" json:"ranker,omitempty"`
+	// Why hotels among the candidates were left out: the reason each of
+	// their plans cannot be booked for the stay. Only set on the first page.
+	Exclusions []*proto1.Exclusion `protobuf:"bytes,5,rep,name=exclusions,proto3" json:"exclusions,omitempty"`
+	// Set when more hotels matched than search considers: only the nearest
+	// ones were ranked and paged, so narrow the search to reach the others.
+	Truncated     bool `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_internal_services_search_proto_search_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SearchResult) String() string {
@@ -119,8 +629,8 @@ func (x *SearchResult) String() string {
 func (*SearchResult) ProtoMessage() {}
 
 func (x *SearchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_services_search_proto_search_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_internal_services_search_proto_search_proto_msgTypes[5]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -132,7 +642,7 @@ func (x *SearchResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
 func (*SearchResult) Descriptor() ([]byte, []int) {
-	return file_internal_services_search_proto_search_proto_rawDescGZIP(), []int{1}
+	return file_internal_services_search_proto_search_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SearchResult) GetHotelIds() []string {
@@ -142,56 +652,261 @@ func (x *SearchResult) GetHotelIds() []string {
 	return nil
 }
 
-var File_internal_services_search_proto_search_proto protoreflect.FileDescriptor
+func (x *SearchResult) GetHotels() []*Hotel {
+	if x != nil {
+		return x.Hotels
+	}
+	return nil
+}
 
-var file_internal_services_search_proto_search_proto_rawDesc = []byte{
-	0x0a, 0x2b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x65, 0x0a, 0x0d, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x6c, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e,
-	0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x65, 0x22, 0x2a, 0x0a, 0x0c,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x68, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
-	0x68, 0x6f, 0x74, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x32, 0x3f, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x12, 0x35, 0x0a, 0x06, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x12, 0x15, 0x2e, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x22, 0x5a, 0x20, 0x2e, 0x2f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *SearchResult) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *SearchResult) GetRanker() string {
+	if x != nil {
+		return x.Ranker
+	}
+	return ""
 }
 
+func (x *SearchResult) GetExclusions() []*proto1.Exclusion {
+	if x != nil {
+		return x.Exclusions
+	}
+	return nil
+}
+
+func (x *SearchResult) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type Hotel struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RatePlans []*proto1.RatePlan     `protobuf:"bytes,2,rep,name=ratePlans,proto3" json:"ratePlans,omitempty"`
+	// Location reported by geo. Unset for city searches.
+	Lat        float64 `protobuf:"fixed64,3,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon        float64 `protobuf:"fixed64,4,opt,name=lon,proto3" json:"lon,omitempty"`
+	DistanceKm float64 `protobuf:"fixed64,5,opt,name=distanceKm,proto3" json:"distanceKm,omitempty"`
+	// Score assigned by the ranker, from 0 to 1 where higher ranks first.
+	Score         float64 `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hotel) Reset() {
+	*x = Hotel{}
+	mi := &file_internal_services_search_proto_search_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hotel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hotel) ProtoMessage() {}
+
+func (x *Hotel) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_services_search_proto_search_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hotel.ProtoReflect.Descriptor instead.
+func (*Hotel) Descriptor() ([]byte, []int) {
+	return file_internal_services_search_proto_search_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Hotel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Hotel) GetRatePlans() []*proto1.RatePlan {
+	if x != nil {
+		return x.RatePlans
+	}
+	return nil
+}
+
+func (x *Hotel) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *Hotel) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *Hotel) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *Hotel) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+var File_internal_services_search_proto_search_proto protoreflect.FileDescriptor
+
+const file_internal_services_search_proto_search_proto_rawDesc = "" +
+	"\n" +
+	"+internal/services/search/proto/search.proto\x12\x06search\x1a%internal/services/geo/proto/geo.proto\x1a'internal/services/rate/proto/rate.proto\"\xf5\x03\n" +
+	"\rNearbyRequest\x12\x14\n" +
+	"\x03lat\x18\x01 \x01(\x02B\x02\x18\x01R\x03lat\x12\x14\n" +
+	"\x03lon\x18\x02 \x01(\x02B\x02\x18\x01R\x03lon\x12\x16\n" +
+	"\x06inDate\x18\x03 \x01(\tR\x06inDate\x12\x18\n" +
+	"\aoutDate\x18\x04 \x01(\tR\aoutDate\x12\x1a\n" +
+	"\bradiusKm\x18\x05 \x01(\x02R\bradiusKm\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\a \x01(\tR\x05order\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\t \x01(\tR\x06cursor\x12)\n" +
+	"\aweights\x18\n" +
+	" \x01(\v2\x0f.search.WeightsR\aweights\x12\x18\n" +
+	"\anearest\x18\v \x01(\x05R\anearest\x12\x1f\n" +
+	"\blatitude\x18\f \x01(\x01H\x00R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\r \x01(\x01H\x01R\tlongitude\x88\x01\x01\x124\n" +
+	"\tratePlans\x18\x0e \x01(\v2\x16.search.RatePlanFilterR\tratePlans\x12\x1a\n" +
+	"\bcurrency\x18\x0f \x01(\tR\bcurrency\x12\x1c\n" +
+	"\tamenities\x18\x10 \x03(\tR\tamenitiesB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\xc6\x02\n" +
+	"\vCityRequest\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x16\n" +
+	"\x06inDate\x18\x02 \x01(\tR\x06inDate\x12\x18\n" +
+	"\aoutDate\x18\x03 \x01(\tR\aoutDate\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\x05 \x01(\tR\x05order\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursor\x12)\n" +
+	"\aweights\x18\b \x01(\v2\x0f.search.WeightsR\aweights\x124\n" +
+	"\tratePlans\x18\t \x01(\v2\x16.search.RatePlanFilterR\tratePlans\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\x12\x1c\n" +
+	"\tamenities\x18\v \x03(\tR\tamenities\"\x8e\x03\n" +
+	"\rWithinRequest\x12+\n" +
+	"\tnorthEast\x18\x01 \x01(\v2\r.geo.LocationR\tnorthEast\x12+\n" +
+	"\tsouthWest\x18\x02 \x01(\v2\r.geo.LocationR\tsouthWest\x12\x16\n" +
+	"\x06inDate\x18\x03 \x01(\tR\x06inDate\x12\x18\n" +
+	"\aoutDate\x18\x04 \x01(\tR\aoutDate\x12\x12\n" +
+	"\x04sort\x18\x05 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\x06 \x01(\tR\x05order\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursor\x12)\n" +
+	"\aweights\x18\t \x01(\v2\x0f.search.WeightsR\aweights\x124\n" +
+	"\tratePlans\x18\n" +
+	" \x01(\v2\x16.search.RatePlanFilterR\tratePlans\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency\x12\x1c\n" +
+	"\tamenities\x18\f \x03(\tR\tamenities\"S\n" +
+	"\aWeights\x12\x1a\n" +
+	"\bdistance\x18\x01 \x01(\x01R\bdistance\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x01R\x06rating\"^\n" +
+	"\x0eRatePlanFilter\x12\x1c\n" +
+	"\trateCodes\x18\x01 \x03(\tR\trateCodes\x12\x1c\n" +
+	"\troomCodes\x18\x02 \x03(\tR\troomCodes\x12\x10\n" +
+	"\x03all\x18\x03 \x01(\bR\x03all\"\xd8\x01\n" +
+	"\fSearchResult\x12\x1a\n" +
+	"\bhotelIds\x18\x01 \x03(\tR\bhotelIds\x12%\n" +
+	"\x06hotels\x18\x02 \x03(\v2\r.search.HotelR\x06hotels\x12\x1e\n" +
+	"\n" +
+	"nextCursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x12\x16\n" +
+	"\x06ranker\x18\x04 \x01(\tR\x06ranker\x12/\n" +
+	"\n" +
+	"exclusions\x18\x05 \x03(\v2\x0f.rate.ExclusionR\n" +
+	"exclusions\x12\x1c\n" +
+	"\ttruncated\x18\x06 \x01(\bR\ttruncated\"\x9f\x01\n" +
+	"\x05Hotel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\tratePlans\x18\x02 \x03(\v2\x0e.rate.RatePlanR\tratePlans\x12\x10\n" +
+	"\x03lat\x18\x03 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x04 \x01(\x01R\x03lon\x12\x1e\n" +
+	"\n" +
+	"distanceKm\x18\x05 \x01(\x01R\n" +
+	"distanceKm\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05score2\xa9\x01\n" +
+	"\x06Search\x125\n" +
+	"\x06Nearby\x12\x15.search.NearbyRequest\x1a\x14.search.SearchResult\x121\n" +
+	"\x04City\x12\x13.search.CityRequest\x1a\x14.search.SearchResult\x125\n" +
+	"\x06Within\x12\x15.search.WithinRequest\x1a\x14.search.SearchResultB\"Z ./internal/services/search/protob\x06proto3"
+
 var (
 	file_internal_services_search_proto_search_proto_rawDescOnce sync.Once
-	file_internal_services_search_proto_search_proto_rawDescData = file_internal_services_search_proto_search_proto_rawDesc
+	file_internal_services_search_proto_search_proto_rawDescData []byte
 )
 
 func file_internal_services_search_proto_search_proto_rawDescGZIP() []byte {
 	file_internal_services_search_proto_search_proto_rawDescOnce.Do(func() {
-		file_internal_services_search_proto_search_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_services_search_proto_search_proto_rawDescData)
+		file_internal_services_search_proto_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_services_search_proto_search_proto_rawDesc), len(file_internal_services_search_proto_search_proto_rawDesc)))
 	})
 	return file_internal_services_search_proto_search_proto_rawDescData
 }
 
-var file_internal_services_search_proto_search_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_internal_services_search_proto_search_proto_goTypes = []interface{}{
-	(*NearbyRequest)(nil), // 0: search.NearbyRequest
-	(*SearchResult)(nil),  // 1: search.SearchResult
+var file_internal_services_search_proto_search_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_internal_services_search_proto_search_proto_goTypes = []any{
+	(*NearbyRequest)(nil),    // 0: search.NearbyRequest
+	(*CityRequest)(nil),      // 1: search.CityRequest
+	(*WithinRequest)(nil),    // 2: search.WithinRequest
+	(*Weights)(nil),          // 3: search.Weights
+	(*RatePlanFilter)(nil),   // 4: search.RatePlanFilter
+	(*SearchResult)(nil),     // 5: search.SearchResult
+	(*Hotel)(nil),            // 6: search.Hotel
+	(*proto.Location)(nil),   // 7: geo.Location
+	(*proto1.Exclusion)(nil), // 8: rate.Exclusion
+	(*proto1.RatePlan)(nil),  // 9: rate.RatePlan
 }
 var file_internal_services_search_proto_search_proto_depIdxs = []int32{
-	0, // 0: search.Search.Nearby:input_type -> search.NearbyRequest
-	1, // 1: search.Search.Nearby:output_type -> search.SearchResult
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3,  // 0: search.NearbyRequest.weights:type_name -> search.Weights
+	4,  // 1: search.NearbyRequest.ratePlans:type_name -> search.RatePlanFilter
+	3,  // 2: search.CityRequest.weights:type_name -> search.Weights
+	4,  // 3: search.CityRequest.ratePlans:type_name -> search.RatePlanFilter
+	7,  // 4: search.WithinRequest.northEast:type_name -> geo.Location
+	7,  // 5: search.WithinRequest.southWest:type_name -> geo.Location
+	3,  // 6: search.WithinRequest.weights:type_name -> search.Weights
+	4,  // 7: search.WithinRequest.ratePlans:type_name -> search.RatePlanFilter
+	6,  // 8: search.SearchResult.hotels:type_name -> search.Hotel
+	8,  // 9: search.SearchResult.exclusions:type_name -> rate.Exclusion
+	9,  // 10: search.Hotel.ratePlans:type_name -> rate.RatePlan
+	0,  // 11: search.Search.Nearby:input_type -> search.NearbyRequest
+	1,  // 12: search.Search.City:input_type -> search.CityRequest
+	2,  // 13: search.Search.Within:input_type -> search.WithinRequest
+	5,  // 14: search.Search.Nearby:output_type -> search.SearchResult
+	5,  // 15: search.Search.City:output_type -> search.SearchResult
+	5,  // 16: search.Search.Within:output_type -> search.SearchResult
+	14, // [14:17] is the sub-list for method output_type
+	11, // [11:14] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_internal_services_search_proto_search_proto_init() }
@@ -199,39 +914,14 @@ func file_internal_services_search_proto_search_proto_init() {
 	if File_internal_services_search_proto_search_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_internal_services_search_proto_search_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NearbyRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_internal_services_search_proto_search_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchResult); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
+	file_internal_services_search_proto_search_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_internal_services_search_proto_search_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_services_search_proto_search_proto_rawDesc), len(file_internal_services_search_proto_search_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -240,87 +930,6 @@ func file_internal_services_search_proto_search_proto_init() {
 		MessageInfos:      file_internal_services_search_proto_search_proto_msgTypes,
 	}.Build()
 	File_internal_services_search_proto_search_proto = out.File
-	file_internal_services_search_proto_search_proto_rawDesc = nil
 	file_internal_services_search_proto_search_proto_goTypes = nil
 	file_internal_services_search_proto_search_proto_depIdxs = nil
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
-
-// SearchClient is the client API for Search service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SearchClient interface {
-	Nearby(ctx context.Context, in *NearbyRequest, opts ...grpc.CallOption) (*SearchResult, error)
-}
-
-type searchClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewSearchClient(cc grpc.ClientConnInterface) SearchClient {
-	return &searchClient{cc}
-}
-
-func (c *searchClient) Nearby(ctx context.Context, in *NearbyRequest, opts ...grpc.CallOption) (*SearchResult, error) {
-	out := new(SearchResult)
-	err := c.cc.Invoke(ctx, "/search.Search/Nearby", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// SearchServer is the server API for Search service.
-type SearchServer interface {
-	Nearby(context.Context, *NearbyRequest) (*SearchResult, error)
-}
-
-// UnimplementedSearchServer can be embedded to have forward compatible implementations.
-type UnimplementedSearchServer struct {
-}
-
-func (*UnimplementedSearchServer) Nearby(context.Context, *NearbyRequest) (*SearchResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Nearby not implemented")
-}
-
-func RegisterSearchServer(s *grpc.Server, srv SearchServer) {
-	s.RegisterService(&_Search_serviceDesc, srv)
-}
-
-func _Search_Nearby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NearbyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SearchServer).Nearby(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/search.Search/Nearby",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SearchServer).Nearby(ctx, req.(*NearbyRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Search_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "search.Search",
-	HandlerType: (*SearchServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Nearby",
-			Handler:    _Search_Nearby_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "internal/services/search/proto/search.proto",
-}
@@ -0,0 +1,123 @@
+package search
+
+import (
+	"fmt"
+
+	"github.com/harlow/go-micro-services/internal/rpcerr"
+	search "github.com/harlow/go-micro-services/internal/services/search/proto"
+)
+
+// Ranker scores the hotels matched by a search. Scores range from 0 to 1
+// and hotels with higher scores rank first.
+type Ranker interface {
+	Score(hotels []*search.Hotel) []float64
+}
+
+// defaultWeights blends the signals when the caller asks for the weighted
+// ranker without weights of their own.
+var defaultWeights = &search.Weights{Distance: 0.4, Price: 0.3, Rating: 0.3}
+
+// newRanker returns the built-in ranker selected by a sort value.
+func (s *Search) newRanker(name string, weights *search.Weights) (Ranker, error) {
+	switch name {
+	case "", "distance":
+		return distanceRanker{}, nil
+	case "price":
+		return priceRanker{}, nil
+	case "rating":
+		return ratingRanker{ratings: s.ratings}, nil
+	case "weighted":
+		if weights == nil || weights.Distance+weights.Price+weights.Rating == 0 {
+			weights = defaultWeights
+		}
+		if weights.Distance < 0 || weights.Price < 0 || weights.Rating < 0 {
+			return nil, rpcerr.InvalidArgument("weights", "must not be negative")
+		}
+		return weightedRanker{
+			rankers: []Ranker{distanceRanker{}, priceRanker{}, ratingRanker{ratings: s.ratings}},
+			weights: []float64{weights.Distance, weights.Price, weights.Rating},
+		}, nil
+	default:
+		return nil, rpcerr.InvalidArgument("sort", fmt.Sprintf("unknown sort %q", name))
+	}
+}
+
+// distanceRanker favors the closest hotels.
+type distanceRanker struct{}
+
+func (distanceRanker) Score(hotels []*search.Hotel) []float64 {
+	return normalize(hotels, func(h *search.Hotel) float64 { return -h.DistanceKm })
+}
+
+// priceRanker favors the cheapest hotels.
+type priceRanker struct{}
+
+func (priceRanker) Score(hotels []*search.Hotel) []float64 {
+	return normalize(hotels, func(h *search.Hotel) float64 { return -lowestRate(h) })
+}
+
+// ratingRanker favors the best rated hotels on a five point scale.
+type ratingRanker struct {
+	ratings map[string]float64
+}
+
+func (r ratingRanker) Score(hotels []*search.Hotel) []float64 {
+	scores := make([]float64, len(hotels))
+	for i, h := range hotels {
+		scores[i] = r.ratings[h.Id] / 5
+	}
+	return scores
+}
+
+// weightedRanker blends the scores of other rankers.
+type weightedRanker struct {
+	rankers []Ranker
+	weights []float64
+}
+
+func (r weightedRanker) Score(hotels []*search.Hotel) []float64 {
+	var total float64
+	for _, w := range r.weights {
+		total += w
+	}
+
+	scores := make([]float64, len(hotels))
+	for i, ranker := range r.rankers {
+		if r.weights[i] == 0 {
+			continue
+		}
+		for j, score := range ranker.Score(hotels) {
+			scores[j] += score * r.weights[i] / total
+		}
+	}
+	return scores
+}
+
+// normalize rescales value across hotels so the highest value scores 1 and
+// the lowest 0. Hotels all score 1 when their values are equal.
+func normalize(hotels []*search.Hotel, value func(*search.Hotel) float64) []float64 {
+	scores := make([]float64, len(hotels))
+	if len(hotels) == 0 {
+		return scores
+	}
+
+	lo, hi := value(hotels[0]), value(hotels[0])
+	for _, h := range hotels[1:] {
+		v := value(h)
+		if v < lo {
+			lo = v
+		}
+		if v > hi {
+			hi = v
+		}
+	}
+
+	for i, h := range hotels {
+		if hi == lo {
+			scores[i] = 1
+			continue
+		}
+		scores[i] = (value(h) - lo) / (hi - lo)
+	}
+	return scores
+}
@@ -2,35 +2,79 @@ package search
 
 import (
 	"fmt"
+	"hash/fnv"
 	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/harlow/go-micro-services/internal/amenities"
+	"github.com/harlow/go-micro-services/internal/cursor"
+	"github.com/harlow/go-micro-services/internal/ratings"
+	"github.com/harlow/go-micro-services/internal/rpcerr"
 	runtime "github.com/harlow/go-micro-services/internal/runtime"
 	geo "github.com/harlow/go-micro-services/internal/services/geo/proto"
+	profile "github.com/harlow/go-micro-services/internal/services/profile/proto"
 	rate "github.com/harlow/go-micro-services/internal/services/rate/proto"
 	search "github.com/harlow/go-micro-services/internal/services/search/proto"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	context "golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	// maxCandidates bounds how many nearby hotels are collected from geo
+	// before sorting and paging.
+	maxCandidates = 500
+	geoPageSize   = 100
+	// maxGeoRestarts bounds how often collecting candidates starts over
+	// because hotel locations changed between geo pages.
+	maxGeoRestarts = 3
+)
+
+// Timeouts holds the deadline budget for each downstream call. Budgets only
+// ever shorten the deadline of the incoming request, and a zero budget
+// inherits it unchanged.
+type Timeouts struct {
+	Geo     time.Duration
+	Rate    time.Duration
+	Profile time.Duration
+}
+
 // New returns a new server
-func New(geoconn, rateconn *grpc.ClientConn) *Search {
+func New(geoconn, rateconn, profileconn *grpc.ClientConn, timeouts Timeouts) *Search {
 	return &Search{
-		geoClient:  geo.NewGeoClient(geoconn),
-		rateClient: rate.NewRateClient(rateconn),
+		geoClient:     geo.NewGeoClient(geoconn),
+		rateClient:    rate.NewRateClient(rateconn),
+		profileClient: profile.NewProfileClient(profileconn),
+		ratings:       ratings.Load("data/hotel_ratings.json"),
+		timeouts:      timeouts,
 	}
 }
 
 // Search implments the search service
 type Search struct {
-	geoClient  geo.GeoClient
-	rateClient rate.RateClient
+	search.UnimplementedSearchServer
+	geoClient     geo.GeoClient
+	rateClient    rate.RateClient
+	profileClient profile.ProfileClient
+	ratings       map[string]float64
+	timeouts      Timeouts
 }
 
 // Run starts the server
 func (s *Search) Run(port int) error {
 	srv := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(runtime.RejectWhileDraining),
 	)
 	search.RegisterSearchServer(srv, s)
 
@@ -43,30 +87,517 @@ func (s *Search) Run(port int) error {
 
 // Nearby returns ids of nearby hotels ordered by ranking algo
 func (s *Search) Nearby(ctx context.Context, req *search.NearbyRequest) (*search.SearchResult, error) {
-	// find nearby hotels
-	nearby, err := s.geoClient.Nearby(ctx, &geo.Request{
-		Lat: req.Lat,
-		Lon: req.Lon,
+	ranker, reverse, err := s.rankParams(req.Sort, req.Order, req.Weights)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit, key, err := pageParams(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAmenities(req.Amenities); err != nil {
+		return nil, err
+	}
+
+	// find nearby hotels, nearest first
+	points, truncated, err := s.nearbyPoints(ctx, req)
+	if err != nil {
+		return nil, rpcerr.Wrap(err, "nearby")
+	}
+	points, err = s.withAmenities(ctx, points, req.Amenities)
+	if err != nil {
+		return nil, err
+	}
+
+	hotels, excluded, err := s.available(ctx, points, req.InDate, req.OutDate, req.Currency, req.RatePlans)
+	if err != nil {
+		return nil, err
+	}
+	rankHotels(hotels, ranker, reverse)
+
+	res := paginate(hotels, offset, limit, key)
+	if offset == 0 {
+		res.Exclusions = excluded
+	}
+	res.Ranker = rankerName(req.Sort)
+	res.Truncated = truncated
+	return res, nil
+}
+
+// Within returns ids of hotels inside a map viewport ordered by ranking algo
+func (s *Search) Within(ctx context.Context, req *search.WithinRequest) (*search.SearchResult, error) {
+	ranker, reverse, err := s.rankParams(req.Sort, req.Order, req.Weights)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit, key, err := pageParams(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAmenities(req.Amenities); err != nil {
+		return nil, err
+	}
+
+	// find hotels in the viewport, nearest its center first
+	points, truncated, err := s.geoPages(ctx, func(ctx context.Context, next string) (*geo.Result, error) {
+		return s.geoClient.Within(ctx, &geo.BoundingBox{
+			NorthEast: req.NorthEast,
+			SouthWest: req.SouthWest,
+			Limit:     geoPageSize,
+			Cursor:    next,
+		})
+	})
+	if err != nil {
+		return nil, rpcerr.Wrap(err, "within")
+	}
+	points, err = s.withAmenities(ctx, points, req.Amenities)
+	if err != nil {
+		return nil, err
+	}
+
+	hotels, excluded, err := s.available(ctx, points, req.InDate, req.OutDate, req.Currency, req.RatePlans)
+	if err != nil {
+		return nil, err
+	}
+	rankHotels(hotels, ranker, reverse)
+
+	res := paginate(hotels, offset, limit, key)
+	if offset == 0 {
+		res.Exclusions = excluded
+	}
+	res.Ranker = rankerName(req.Sort)
+	res.Truncated = truncated
+	return res, nil
+}
+
+// City returns ids of hotels in the requested city
+func (s *Search) City(ctx context.Context, req *search.CityRequest) (*search.SearchResult, error) {
+	if req.Sort == "distance" {
+		return nil, rpcerr.InvalidArgument("sort", "city search cannot sort by distance")
+	}
+	var (
+		ranker  Ranker
+		reverse bool
+		err     error
+	)
+	if req.Sort != "" {
+		ranker, reverse, err = s.rankParams(req.Sort, req.Order, req.Weights)
+		if err != nil {
+			return nil, err
+		}
+	}
+	offset, limit, key, err := pageParams(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAmenities(req.Amenities); err != nil {
+		return nil, err
+	}
+
+	// find hotels in the city
+	profileCtx, cancel := runtime.WithBudget(ctx, s.timeouts.Profile)
+	defer cancel()
+	city, err := s.profileClient.FindByCity(profileCtx, &profile.CityRequest{
+		City: req.City,
+	})
+	if err != nil {
+		return nil, rpcerr.Wrap(err, "city")
+	}
+
+	points := make([]*geo.Point, 0, len(city.HotelIds))
+	for _, id := range city.HotelIds {
+		points = append(points, &geo.Point{HotelId: id})
+	}
+	points, err = s.withAmenities(ctx, points, req.Amenities)
+	if err != nil {
+		return nil, err
+	}
+
+	hotels, excluded, err := s.available(ctx, points, req.InDate, req.OutDate, req.Currency, req.RatePlans)
+	if err != nil {
+		return nil, err
+	}
+	if ranker != nil {
+		rankHotels(hotels, ranker, reverse)
+	}
+
+	res := paginate(hotels, offset, limit, key)
+	if offset == 0 {
+		res.Exclusions = excluded
+	}
+	res.Ranker = req.Sort
+	return res, nil
+}
+
+// nearbyPoints pages through geo results until they run out or
+// maxCandidates is reached, reporting whether hotels were left out.
+// Nearest searches fit in a single geo page.
+func (s *Search) nearbyPoints(ctx context.Context, req *search.NearbyRequest) ([]*geo.Point, bool, error) {
+	// send geo both precisions while servers may still read only the floats
+	lat, lon := requestLocation(req)
+
+	if req.Nearest != 0 {
+		nearby, err := s.geoNearby(ctx, &geo.Request{
+			Lat:       float32(lat),
+			Lon:       float32(lon),
+			Latitude:  &lat,
+			Longitude: &lon,
+			Limit:     req.Nearest,
+			Nearest:   true,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		return resultPoints(nearby), false, nil
+	}
+
+	return s.geoPages(ctx, func(ctx context.Context, next string) (*geo.Result, error) {
+		return s.geoClient.Nearby(ctx, &geo.Request{
+			Lat:       float32(lat),
+			Lon:       float32(lon),
+			Latitude:  &lat,
+			Longitude: &lon,
+			RadiusKm:  req.RadiusKm,
+			Limit:     geoPageSize,
+			Cursor:    next,
+		})
+	})
+}
+
+// requestLocation returns the location of req, preferring the double
+// precision fields over the deprecated float ones.
+func requestLocation(req *search.NearbyRequest) (float64, float64) {
+	lat, lon := float64(req.Lat), float64(req.Lon)
+	if req.Latitude != nil {
+		lat = *req.Latitude
+	}
+	if req.Longitude != nil {
+		lon = *req.Longitude
+	}
+	return lat, lon
+}
+
+// geoPages collects the points of successive geo result pages until they
+// run out or maxCandidates is reached, reporting whether points were left
+// out. Each page gets its own Geo budget. Geo rejects the cursor of a page
+// once hotel locations change, and the pages are then collected again from
+// the first so no hotel is skipped or seen twice.
+func (s *Search) geoPages(ctx context.Context, fetch func(ctx context.Context, cursor string) (*geo.Result, error)) ([]*geo.Point, bool, error) {
+	var (
+		points   []*geo.Point
+		next     string
+		restarts int
+	)
+	for {
+		pageCtx, cancel := runtime.WithBudget(ctx, s.timeouts.Geo)
+		res, err := fetch(pageCtx, next)
+		cancel()
+		if err != nil && next != "" && status.Code(err) == codes.FailedPrecondition && restarts < maxGeoRestarts {
+			points, next = nil, ""
+			restarts++
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+
+		points = append(points, resultPoints(res)...)
+		next = res.NextCursor
+		if next == "" || len(points) >= maxCandidates {
+			break
+		}
+	}
+
+	truncated := next != "" || len(points) > maxCandidates
+	if len(points) > maxCandidates {
+		points = points[:maxCandidates]
+	}
+	return points, truncated, nil
+}
+
+// resultPoints returns the locations of the hotels in a geo result, falling
+// back to bare ids for servers that do not report locations.
+func resultPoints(res *geo.Result) []*geo.Point {
+	points := make([]*geo.Point, 0, len(res.HotelIds))
+	for i, id := range res.HotelIds {
+		p := &geo.Point{HotelId: id}
+		if i < len(res.Points) {
+			p = res.Points[i]
+		}
+		points = append(points, p)
+	}
+	return points
+}
+
+func (s *Search) geoNearby(ctx context.Context, req *geo.Request) (*geo.Result, error) {
+	ctx, cancel := runtime.WithBudget(ctx, s.timeouts.Geo)
+	defer cancel()
+	return s.geoClient.Nearby(ctx, req)
+}
+
+// withAmenities narrows the candidates down to the hotels offering every
+// one of amenities, keeping the candidate order. Only the amenities of each
+// profile are fetched.
+func (s *Search) withAmenities(ctx context.Context, candidates []*geo.Point, amenities []string) ([]*geo.Point, error) {
+	if len(amenities) == 0 || len(candidates) == 0 {
+		return candidates, nil
+	}
+
+	hotelIDs := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		hotelIDs = append(hotelIDs, c.HotelId)
+	}
+
+	ctx, cancel := runtime.WithBudget(ctx, s.timeouts.Profile)
+	defer cancel()
+	profiles, err := s.profileClient.GetProfiles(ctx, &profile.Request{
+		HotelIds:  hotelIDs,
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"amenities"}},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("nearby error: %w", err)
+		return nil, rpcerr.Wrap(err, "amenities")
+	}
+
+	offered := make(map[string]map[string]bool, len(profiles.Hotels))
+	for _, h := range profiles.Hotels {
+		offered[h.Id] = make(map[string]bool, len(h.Amenities))
+		for _, a := range h.Amenities {
+			offered[h.Id][a] = true
+		}
+	}
+
+	var matched []*geo.Point
+	for _, c := range candidates {
+		if offersAll(offered[c.HotelId], amenities) {
+			matched = append(matched, c)
+		}
+	}
+	return matched, nil
+}
+
+// checkAmenities rejects amenities that no hotel can offer, which would
+// otherwise silently match nothing.
+func checkAmenities(names []string) error {
+	for _, a := range names {
+		if !amenities.Known(a) {
+			return rpcerr.InvalidArgument("amenities", fmt.Sprintf("unknown amenity %q; expected any of %s", a, strings.Join(amenities.Names, ", ")))
+		}
+	}
+	return nil
+}
+
+func offersAll(offered map[string]bool, amenities []string) bool {
+	for _, a := range amenities {
+		if !offered[a] {
+			return false
+		}
+	}
+	return true
+}
+
+// available narrows the candidates down to the hotels with rates for the
+// stay, quoted in currency, keeping the candidate order. Each hotel keeps
+// only its cheapest plan unless the filter asks for all of them. Hotels
+// left out because none of their plans can be booked for the stay are
+// returned with the reasons rate gave for each plan.
+func (s *Search) available(ctx context.Context, candidates []*geo.Point, inDate, outDate, currency string, filter *search.RatePlanFilter) ([]*search.Hotel, []*rate.Exclusion, error) {
+	if len(candidates) == 0 {
+		return nil, nil, nil
+	}
+
+	hotelIDs := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		hotelIDs = append(hotelIDs, c.HotelId)
 	}
 
 	// find rates for hotels
+	ctx, cancel := runtime.WithBudget(ctx, s.timeouts.Rate)
+	defer cancel()
 	rates, err := s.rateClient.GetRates(ctx, &rate.Request{
-		HotelIds: nearby.HotelIds,
-		InDate:   req.InDate,
-		OutDate:  req.OutDate,
+		HotelIds:  hotelIDs,
+		InDate:    inDate,
+		OutDate:   outDate,
+		RateCodes: filter.GetRateCodes(),
+		RoomCodes: filter.GetRoomCodes(),
+		Currency:  currency,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("rates error: %w", err)
+		return nil, nil, rpcerr.Wrap(err, "rates")
 	}
 
-	// build the response
-	res := new(search.SearchResult)
+	// group rate plans and exclusions by hotel
+	plans := make(map[string][]*rate.RatePlan)
 	for _, ratePlan := range rates.RatePlans {
-		res.HotelIds = append(res.HotelIds, ratePlan.HotelId)
+		plans[ratePlan.HotelId] = append(plans[ratePlan.HotelId], ratePlan)
+	}
+	exclusions := make(map[string][]*rate.Exclusion)
+	for _, e := range rates.Exclusions {
+		exclusions[e.HotelId] = append(exclusions[e.HotelId], e)
 	}
 
-	return res, nil
+	hotels := make([]*search.Hotel, 0, len(plans))
+	var excluded []*rate.Exclusion
+	for _, c := range candidates {
+		p, ok := plans[c.HotelId]
+		if !ok {
+			excluded = append(excluded, exclusions[c.HotelId]...)
+			delete(exclusions, c.HotelId)
+			continue
+		}
+		if !filter.GetAll() {
+			p = []*rate.RatePlan{cheapestPlan(p)}
+		}
+		hotels = append(hotels, &search.Hotel{
+			Id:         c.HotelId,
+			RatePlans:  p,
+			Lat:        c.Lat,
+			Lon:        c.Lon,
+			DistanceKm: c.DistanceKm,
+		})
+		delete(plans, c.HotelId)
+	}
+	return hotels, excluded, nil
+}
+
+// cheapestPlan returns the plan with the lowest total price including
+// taxes, the first one on a tie.
+func cheapestPlan(plans []*rate.RatePlan) *rate.RatePlan {
+	cheapest := plans[0]
+	for _, p := range plans[1:] {
+		if p.RoomType.GetTotalRateInclusive() < cheapest.RoomType.GetTotalRateInclusive() {
+			cheapest = p
+		}
+	}
+	return cheapest
+}
+
+// rankHotels scores hotels with ranker and orders them best first, or worst
+// first when reverse is set. Ties keep the candidate order, which reverse
+// flips as well so a reversed distance sort still runs farthest first.
+func rankHotels(hotels []*search.Hotel, ranker Ranker, reverse bool) {
+	for i, score := range ranker.Score(hotels) {
+		hotels[i].Score = score
+	}
+
+	if reverse {
+		for i, j := 0, len(hotels)-1; i < j; i, j = i+1, j-1 {
+			hotels[i], hotels[j] = hotels[j], hotels[i]
+		}
+	}
+	sort.SliceStable(hotels, func(i, j int) bool {
+		if reverse {
+			return hotels[i].Score < hotels[j].Score
+		}
+		return hotels[i].Score > hotels[j].Score
+	})
+}
+
+// lowestRate returns the cheapest inclusive total across a hotel's plans.
+func lowestRate(h *search.Hotel) float64 {
+	var lowest float64
+	for i, p := range h.RatePlans {
+		total := p.GetRoomType().GetTotalRateInclusive()
+		if i == 0 || total < lowest {
+			lowest = total
+		}
+	}
+	return lowest
+}
+
+// rankParams resolves the ranker for a sort key and whether order asks for
+// the worst scores first. Distance and price read naturally ascending
+// (nearest, cheapest first) while rating and weighted read descending.
+func (s *Search) rankParams(key, order string, weights *search.Weights) (Ranker, bool, error) {
+	ranker, err := s.newRanker(key, weights)
+	if err != nil {
+		return nil, false, err
+	}
+
+	bestFirst := "asc"
+	if key == "rating" || key == "weighted" {
+		bestFirst = "desc"
+	}
+
+	switch order {
+	case "":
+		return ranker, false, nil
+	case "asc", "desc":
+		return ranker, order != bestFirst, nil
+	default:
+		return nil, false, rpcerr.InvalidArgument("order", fmt.Sprintf("unknown order %q", order))
+	}
+}
+
+// rankerName returns the name of the ranker selected by a sort key.
+func rankerName(key string) string {
+	if key == "" {
+		return "distance"
+	}
+	return key
+}
+
+// pagedRequest is a search request served one page at a time.
+type pagedRequest interface {
+	proto.Message
+	GetLimit() int32
+	GetCursor() string
+}
+
+// pageParams validates the page size and decodes the cursor of req, which
+// must have been handed out for the same query. It returns the key of the
+// query to tie the next cursor to.
+func pageParams(req pagedRequest) (int, int, string, error) {
+	limit := req.GetLimit()
+	if limit < 0 || limit > maxPageSize {
+		return 0, 0, "", rpcerr.InvalidArgument("limit", fmt.Sprintf("must be between 1 and %d", maxPageSize))
+	}
+	if limit == 0 {
+		limit = defaultPageSize
+	}
+
+	key := queryKey(req)
+	offset, cursorKey, err := cursor.Decode(req.GetCursor())
+	if err != nil {
+		return 0, 0, "", rpcerr.InvalidArgument("cursor", err.Error())
+	}
+	if req.GetCursor() != "" && cursorKey != key {
+		return 0, 0, "", rpcerr.InvalidArgument("cursor", "was handed out for a different query")
+	}
+	return offset, int(limit), key, nil
+}
+
+// queryKey hashes every field of req but the page size and cursor, so the
+// pages of one query can be told from those of another.
+func queryKey(req pagedRequest) string {
+	m := proto.Clone(req).ProtoReflect()
+	fields := m.Descriptor().Fields()
+	m.Clear(fields.ByName("limit"))
+	m.Clear(fields.ByName("cursor"))
+
+	b, _ := proto.MarshalOptions{Deterministic: true}.Marshal(m.Interface())
+	h := fnv.New64a()
+	h.Write(b)
+	return strconv.FormatUint(h.Sum64(), 36)
+}
+
+// paginate returns limit hotels from offset, with a cursor to the next page
+// tied to the query with the given key.
+func paginate(hotels []*search.Hotel, offset, limit int, key string) *search.SearchResult {
+	res := new(search.SearchResult)
+	if offset >= len(hotels) {
+		return res
+	}
+
+	end := offset + limit
+	if end < len(hotels) {
+		res.NextCursor = cursor.Encode(end, key)
+	} else {
+		end = len(hotels)
+	}
+
+	for _, h := range hotels[offset:end] {
+		res.HotelIds = append(res.HotelIds, h.Id)
+		res.Hotels = append(res.Hotels, h)
+	}
+	return res
 }
@@ -1,33 +1,76 @@
 package search
 
 import (
+	"reflect"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/harlow/go-micro-services/internal/cursor"
 	geo "github.com/harlow/go-micro-services/internal/services/geo/proto"
+	profile "github.com/harlow/go-micro-services/internal/services/profile/proto"
 	rate "github.com/harlow/go-micro-services/internal/services/rate/proto"
 	searchpb "github.com/harlow/go-micro-services/internal/services/search/proto"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type geoClientStub struct {
-	res *geo.Result
-	err error
+	// methods search never calls are left unimplemented
+	geo.GeoClient
+
+	req      *geo.Request
+	box      *geo.BoundingBox
+	res      *geo.Result
+	err      error
+	deadline time.Time
 }
 
 func (g *geoClientStub) Nearby(ctx context.Context, in *geo.Request, opts ...grpc.CallOption) (*geo.Result, error) {
+	g.req = in
+	g.deadline, _ = ctx.Deadline()
+	return g.res, g.err
+}
+
+func (g *geoClientStub) Within(ctx context.Context, in *geo.BoundingBox, opts ...grpc.CallOption) (*geo.Result, error) {
+	g.box = in
+	g.deadline, _ = ctx.Deadline()
 	return g.res, g.err
 }
 
 type rateClientStub struct {
+	req *rate.Request
 	res *rate.Result
 	err error
 }
 
 func (r *rateClientStub) GetRates(ctx context.Context, in *rate.Request, opts ...grpc.CallOption) (*rate.Result, error) {
+	r.req = in
 	return r.res, r.err
 }
 
+type profileClientStub struct {
+	req      *profile.CityRequest
+	res      *profile.CityResult
+	profiles *profile.Result
+	err      error
+
+	profilesReq *profile.Request
+}
+
+func (p *profileClientStub) GetProfiles(ctx context.Context, in *profile.Request, opts ...grpc.CallOption) (*profile.Result, error) {
+	p.profilesReq = in
+	return p.profiles, p.err
+}
+
+func (p *profileClientStub) FindByCity(ctx context.Context, in *profile.CityRequest, opts ...grpc.CallOption) (*profile.CityResult, error) {
+	p.req = in
+	return p.res, p.err
+}
+
 func TestNearbyReturnsHotelIDsFromRatePlans(t *testing.T) {
 	s := &Search{
 		geoClient: &geoClientStub{res: &geo.Result{HotelIds: []string{"1", "2", "3"}}},
@@ -49,7 +92,547 @@ func TestNearbyReturnsHotelIDsFromRatePlans(t *testing.T) {
 	if len(res.HotelIds) != 2 {
 		t.Fatalf("expected 2 hotel ids, got %d", len(res.HotelIds))
 	}
-	if res.HotelIds[0] != "2" || res.HotelIds[1] != "1" {
+	// hotels keep the nearest-first order from geo by default
+	if res.HotelIds[0] != "1" || res.HotelIds[1] != "2" {
 		t.Fatalf("unexpected hotel ids: %v", res.HotelIds)
 	}
 }
+
+func TestNearbySortsAndPages(t *testing.T) {
+	s := &Search{
+		geoClient: &geoClientStub{res: &geo.Result{HotelIds: []string{"1", "2", "3"}}},
+		rateClient: &rateClientStub{res: &rate.Result{RatePlans: []*rate.RatePlan{
+			{HotelId: "1", RoomType: &rate.RoomType{TotalRateInclusive: 150}},
+			{HotelId: "2", RoomType: &rate.RoomType{TotalRateInclusive: 100}},
+			{HotelId: "3", RoomType: &rate.RoomType{TotalRateInclusive: 125}},
+		}}},
+		ratings: map[string]float64{"1": 4.8, "2": 3.9, "3": 4.2},
+	}
+
+	tests := []struct {
+		sort, order string
+		want        []string
+	}{
+		{"distance", "desc", []string{"3", "2", "1"}},
+		{"price", "", []string{"2", "3", "1"}},
+		{"price", "desc", []string{"1", "3", "2"}},
+		{"rating", "", []string{"1", "3", "2"}},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		req := &searchpb.NearbyRequest{Sort: tt.sort, Order: tt.order, Limit: 2}
+		for {
+			res, err := s.Nearby(context.Background(), req)
+			if err != nil {
+				t.Fatalf("%s %s: Nearby returned error: %v", tt.sort, tt.order, err)
+			}
+			got = append(got, res.HotelIds...)
+			if res.NextCursor == "" {
+				break
+			}
+			req.Cursor = res.NextCursor
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("%s %s: got %v, want %v", tt.sort, tt.order, got, tt.want)
+		}
+	}
+}
+
+func TestNearbyRejectsCursorOfAnotherQuery(t *testing.T) {
+	s := &Search{
+		geoClient: &geoClientStub{res: &geo.Result{HotelIds: []string{"1", "2", "3"}}},
+		rateClient: &rateClientStub{res: &rate.Result{RatePlans: []*rate.RatePlan{
+			{HotelId: "1", RoomType: &rate.RoomType{TotalRateInclusive: 150}},
+			{HotelId: "2", RoomType: &rate.RoomType{TotalRateInclusive: 100}},
+			{HotelId: "3", RoomType: &rate.RoomType{TotalRateInclusive: 125}},
+		}}},
+	}
+
+	req := &searchpb.NearbyRequest{InDate: "2015-04-09", OutDate: "2015-04-10", Sort: "price", Limit: 1}
+	res, err := s.Nearby(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	if res.NextCursor == "" {
+		t.Fatalf("expected a next page")
+	}
+
+	// the page size may change between pages
+	if _, err := s.Nearby(context.Background(), &searchpb.NearbyRequest{InDate: "2015-04-09", OutDate: "2015-04-10", Sort: "price", Limit: 2, Cursor: res.NextCursor}); err != nil {
+		t.Fatalf("Nearby with a new page size returned error: %v", err)
+	}
+
+	for _, other := range []*searchpb.NearbyRequest{
+		{InDate: "2015-04-09", OutDate: "2015-04-10", Sort: "rating", Limit: 1},
+		{InDate: "2015-04-09", OutDate: "2015-04-10", Sort: "price", Limit: 1, Latitude: proto.Float64(40.7)},
+		{InDate: "2015-04-09", OutDate: "2015-04-10", Sort: "price", Limit: 1, Amenities: []string{"wifi"}},
+		{InDate: "2015-04-09", OutDate: "2015-04-10", Sort: "price", Limit: 1, Cursor: cursor.Encode(1, "")},
+	} {
+		if other.Cursor == "" {
+			other.Cursor = res.NextCursor
+		}
+		_, err := s.Nearby(context.Background(), other)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%v: error code = %v, want InvalidArgument", other, status.Code(err))
+		}
+	}
+}
+
+func TestNearbyScoresWithRanker(t *testing.T) {
+	s := &Search{
+		geoClient: &geoClientStub{res: &geo.Result{
+			HotelIds: []string{"1", "2", "3"},
+			Points: []*geo.Point{
+				{HotelId: "1", DistanceKm: 1},
+				{HotelId: "2", DistanceKm: 3},
+				{HotelId: "3", DistanceKm: 2},
+			},
+		}},
+		rateClient: &rateClientStub{res: &rate.Result{RatePlans: []*rate.RatePlan{
+			{HotelId: "1", RoomType: &rate.RoomType{TotalRateInclusive: 300}},
+			{HotelId: "2", RoomType: &rate.RoomType{TotalRateInclusive: 100}},
+			{HotelId: "3", RoomType: &rate.RoomType{TotalRateInclusive: 200}},
+		}}},
+		ratings: map[string]float64{"1": 5, "2": 5, "3": 5},
+	}
+
+	tests := []struct {
+		req    *searchpb.NearbyRequest
+		ranker string
+		want   []string
+		scores []float64
+	}{
+		{&searchpb.NearbyRequest{}, "distance", []string{"1", "3", "2"}, []float64{1, 0.5, 0}},
+		{&searchpb.NearbyRequest{Sort: "weighted", Weights: &searchpb.Weights{Price: 1}}, "weighted", []string{"2", "3", "1"}, []float64{1, 0.5, 0}},
+		{&searchpb.NearbyRequest{Sort: "weighted", Weights: &searchpb.Weights{Distance: 1, Rating: 1}}, "weighted", []string{"1", "3", "2"}, []float64{1, 0.75, 0.5}},
+	}
+
+	for _, tt := range tests {
+		res, err := s.Nearby(context.Background(), tt.req)
+		if err != nil {
+			t.Fatalf("%v: Nearby returned error: %v", tt.req, err)
+		}
+		if res.Ranker != tt.ranker {
+			t.Fatalf("%v: ranker = %q, want %q", tt.req, res.Ranker, tt.ranker)
+		}
+		var got []string
+		var scores []float64
+		for _, h := range res.Hotels {
+			got = append(got, h.Id)
+			scores = append(scores, h.Score)
+		}
+		if !reflect.DeepEqual(got, tt.want) || !reflect.DeepEqual(scores, tt.scores) {
+			t.Fatalf("%v: got %v %v, want %v %v", tt.req, got, scores, tt.want, tt.scores)
+		}
+	}
+}
+
+func TestNearbyRejectsInvalidSort(t *testing.T) {
+	s := &Search{geoClient: &geoClientStub{}, rateClient: &rateClientStub{}}
+
+	_, err := s.Nearby(context.Background(), &searchpb.NearbyRequest{Sort: "stars"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("error code = %v, want InvalidArgument", status.Code(err))
+	}
+}
+
+func TestNearbyReportsTruncatedCandidates(t *testing.T) {
+	page := &geo.Result{NextCursor: "more"}
+	for i := 0; i < geoPageSize; i++ {
+		page.HotelIds = append(page.HotelIds, strconv.Itoa(i))
+	}
+	geoClient := &geoClientStub{res: page}
+	s := &Search{
+		geoClient:  geoClient,
+		rateClient: &rateClientStub{res: &rate.Result{}},
+	}
+
+	res, err := s.Nearby(context.Background(), &searchpb.NearbyRequest{InDate: "2015-04-09", OutDate: "2015-04-10"})
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	if !res.Truncated {
+		t.Fatalf("expected the result to be marked truncated after %d candidates", maxCandidates)
+	}
+
+	page.NextCursor = ""
+	res, err = s.Nearby(context.Background(), &searchpb.NearbyRequest{InDate: "2015-04-09", OutDate: "2015-04-10"})
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	if res.Truncated {
+		t.Fatalf("expected a single page of candidates not to be truncated")
+	}
+}
+
+// pagedGeoStub serves geo results in pages of one hotel. While stale is
+// positive, a page after the first fails with a stale cursor and a new
+// hotel moves in ahead of the others, as an update between pages would.
+type pagedGeoStub struct {
+	geo.GeoClient
+
+	ids   []string
+	stale int
+}
+
+func (g *pagedGeoStub) Nearby(ctx context.Context, in *geo.Request, opts ...grpc.CallOption) (*geo.Result, error) {
+	offset, _, err := cursor.Decode(in.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if offset > 0 && g.stale > 0 {
+		g.stale--
+		g.ids = append([]string{"new" + strconv.Itoa(g.stale)}, g.ids...)
+		return nil, status.Error(codes.FailedPrecondition, "hotel locations changed")
+	}
+
+	res := &geo.Result{HotelIds: g.ids[offset : offset+1]}
+	if offset+1 < len(g.ids) {
+		res.NextCursor = cursor.Encode(offset+1, "")
+	}
+	return res, nil
+}
+
+func TestNearbyRestartsWhenGeoCursorIsStale(t *testing.T) {
+	rateClient := &rateClientStub{res: &rate.Result{}}
+	s := &Search{
+		geoClient:  &pagedGeoStub{ids: []string{"1", "2", "3"}, stale: 1},
+		rateClient: rateClient,
+	}
+
+	if _, err := s.Nearby(context.Background(), &searchpb.NearbyRequest{InDate: "2015-04-09", OutDate: "2015-04-10"}); err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	if want := []string{"new0", "1", "2", "3"}; !reflect.DeepEqual(rateClient.req.HotelIds, want) {
+		t.Fatalf("candidates = %v, want %v", rateClient.req.HotelIds, want)
+	}
+
+	// locations that keep changing eventually fail the search
+	s.geoClient = &pagedGeoStub{ids: []string{"1", "2"}, stale: maxGeoRestarts + 1}
+	_, err := s.Nearby(context.Background(), &searchpb.NearbyRequest{InDate: "2015-04-09", OutDate: "2015-04-10"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("error code = %v, want FailedPrecondition", status.Code(err))
+	}
+}
+
+func TestNearbyGroupsRatePlansByHotel(t *testing.T) {
+	rateClient := &rateClientStub{res: &rate.Result{RatePlans: []*rate.RatePlan{
+		{HotelId: "1", Code: "RACK", RoomType: &rate.RoomType{BookableRate: 109}},
+		{HotelId: "2", Code: "RACK", RoomType: &rate.RoomType{BookableRate: 139}},
+		{HotelId: "1", Code: "AAA", RoomType: &rate.RoomType{BookableRate: 99}},
+	}}}
+	s := &Search{
+		geoClient:  &geoClientStub{res: &geo.Result{HotelIds: []string{"1", "2"}}},
+		rateClient: rateClient,
+	}
+
+	res, err := s.Nearby(context.Background(), &searchpb.NearbyRequest{
+		InDate:  "2015-04-09",
+		OutDate: "2015-04-10",
+		RatePlans: &searchpb.RatePlanFilter{
+			RateCodes: []string{"RACK", "AAA"},
+			All:       true,
+		},
+		Currency: "EUR",
+	})
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	if len(res.Hotels) != 2 {
+		t.Fatalf("expected 2 hotels, got %d", len(res.Hotels))
+	}
+	if res.Hotels[0].Id != "1" || len(res.Hotels[0].RatePlans) != 2 {
+		t.Fatalf("expected hotel 1 with 2 rate plans, got %q with %d", res.Hotels[0].Id, len(res.Hotels[0].RatePlans))
+	}
+	if res.Hotels[1].RatePlans[0].RoomType.BookableRate != 139 {
+		t.Fatalf("unexpected hotel 2 rate: %v", res.Hotels[1].RatePlans[0].RoomType)
+	}
+	if len(rateClient.req.RateCodes) != 2 || rateClient.req.Currency != "EUR" {
+		t.Fatalf("rate codes or currency not passed to rate: %v", rateClient.req)
+	}
+}
+
+func TestNearbyKeepsCheapestRatePlan(t *testing.T) {
+	s := &Search{
+		geoClient: &geoClientStub{res: &geo.Result{HotelIds: []string{"1"}}},
+		rateClient: &rateClientStub{res: &rate.Result{RatePlans: []*rate.RatePlan{
+			{HotelId: "1", Code: "RACK", RoomType: &rate.RoomType{TotalRateInclusive: 123.17}},
+			{HotelId: "1", Code: "AAA", RoomType: &rate.RoomType{TotalRateInclusive: 110.74}},
+			{HotelId: "1", Code: "RACK", RoomType: &rate.RoomType{Code: "QN", TotalRateInclusive: 113}},
+		}}},
+	}
+
+	res, err := s.Nearby(context.Background(), &searchpb.NearbyRequest{
+		InDate:  "2015-04-09",
+		OutDate: "2015-04-10",
+	})
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	if len(res.Hotels) != 1 || len(res.Hotels[0].RatePlans) != 1 {
+		t.Fatalf("expected a single rate plan, got %v", res.Hotels)
+	}
+	if res.Hotels[0].RatePlans[0].Code != "AAA" {
+		t.Fatalf("expected the AAA plan, got %v", res.Hotels[0].RatePlans[0])
+	}
+}
+
+func TestNearbyReportsExcludedHotels(t *testing.T) {
+	s := &Search{
+		geoClient: &geoClientStub{res: &geo.Result{HotelIds: []string{"1", "2", "3"}}},
+		rateClient: &rateClientStub{res: &rate.Result{
+			RatePlans: []*rate.RatePlan{
+				{HotelId: "1", Code: "RACK", RoomType: &rate.RoomType{}},
+			},
+			Exclusions: []*rate.Exclusion{
+				{HotelId: "1", Code: "AAA", Reason: "MIN_NIGHTS", Message: "minimum stay 2 nights"},
+				{HotelId: "3", Code: "RACK", Reason: "CLOSED_TO_ARRIVAL", Message: "no arrivals on 2015-04-10"},
+				{HotelId: "2", Code: "RACK", Reason: "MIN_NIGHTS", Message: "minimum stay 2 nights"},
+			},
+		}},
+	}
+
+	req := &searchpb.NearbyRequest{InDate: "2015-04-10", OutDate: "2015-04-11", Limit: 1}
+	res, err := s.Nearby(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	if len(res.Hotels) != 1 {
+		t.Fatalf("expected 1 hotel, got %d", len(res.Hotels))
+	}
+	// hotel 1 is bookable on another plan, so only 2 and 3 are reported,
+	// in candidate order
+	if len(res.Exclusions) != 2 || res.Exclusions[0].HotelId != "2" || res.Exclusions[1].HotelId != "3" {
+		t.Fatalf("unexpected exclusions: %v", res.Exclusions)
+	}
+
+	req.Cursor = cursor.Encode(1, queryKey(req))
+	res, err = s.Nearby(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	if len(res.Exclusions) != 0 {
+		t.Fatalf("exclusions repeated on a later page: %v", res.Exclusions)
+	}
+}
+
+func TestNearbyPassesLocationToGeo(t *testing.T) {
+	geoClient := &geoClientStub{res: &geo.Result{}}
+	s := &Search{
+		geoClient:  geoClient,
+		rateClient: &rateClientStub{res: &rate.Result{}},
+	}
+
+	_, err := s.Nearby(context.Background(), &searchpb.NearbyRequest{
+		Lat:      40.7128,
+		Lon:      -74.006,
+		RadiusKm: 5,
+		InDate:   "2015-04-09",
+		OutDate:  "2015-04-10",
+	})
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	if geoClient.req.Lat != 40.7128 || geoClient.req.Lon != -74.006 || geoClient.req.RadiusKm != 5 {
+		t.Fatalf("unexpected geo request: %v", geoClient.req)
+	}
+}
+
+func TestNearbyPrefersDoubleLocation(t *testing.T) {
+	geoClient := &geoClientStub{res: &geo.Result{}}
+	s := &Search{
+		geoClient:  geoClient,
+		rateClient: &rateClientStub{res: &rate.Result{}},
+	}
+
+	// the equator is a valid double location even though it is zero
+	_, err := s.Nearby(context.Background(), &searchpb.NearbyRequest{
+		Lat:       10,
+		Lon:       -74,
+		Latitude:  proto.Float64(0),
+		Longitude: proto.Float64(-74.0060123),
+	})
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	got := geoClient.req
+	if got.Latitude == nil || *got.Latitude != 0 || got.GetLongitude() != -74.0060123 {
+		t.Fatalf("geo location = (%v, %v), want (0, -74.0060123)", got.Latitude, got.Longitude)
+	}
+	if got.Lat != 0 || got.Lon != float32(-74.0060123) {
+		t.Fatalf("legacy geo location = (%v, %v), want (0, -74.0060123)", got.Lat, got.Lon)
+	}
+}
+
+func TestNearbyAsksGeoForNearestHotels(t *testing.T) {
+	geoClient := &geoClientStub{res: &geo.Result{HotelIds: []string{"7"}, NextCursor: "ignored"}}
+	s := &Search{
+		geoClient:  geoClient,
+		rateClient: &rateClientStub{res: &rate.Result{RatePlans: []*rate.RatePlan{{HotelId: "7"}}}},
+	}
+
+	res, err := s.Nearby(context.Background(), &searchpb.NearbyRequest{Lat: 44.5, Lon: -110.5, Nearest: 3})
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	if !geoClient.req.Nearest || geoClient.req.Limit != 3 || geoClient.req.RadiusKm != 0 {
+		t.Fatalf("unexpected geo request: %v", geoClient.req)
+	}
+	if len(res.HotelIds) != 1 || res.HotelIds[0] != "7" {
+		t.Fatalf("unexpected hotel ids: %v", res.HotelIds)
+	}
+}
+
+func TestWithinSearchesViewport(t *testing.T) {
+	geoClient := &geoClientStub{res: &geo.Result{
+		HotelIds: []string{"1", "2"},
+		Points:   []*geo.Point{{HotelId: "1", DistanceKm: 2}, {HotelId: "2", DistanceKm: 1}},
+	}}
+	s := &Search{
+		geoClient:  geoClient,
+		rateClient: &rateClientStub{res: &rate.Result{RatePlans: []*rate.RatePlan{{HotelId: "1"}, {HotelId: "2"}}}},
+	}
+
+	ne, sw := &geo.Location{Lat: 37.8, Lon: -122.3}, &geo.Location{Lat: 37.7, Lon: -122.5}
+	res, err := s.Within(context.Background(), &searchpb.WithinRequest{NorthEast: ne, SouthWest: sw})
+	if err != nil {
+		t.Fatalf("Within returned error: %v", err)
+	}
+	if geoClient.box.NorthEast != ne || geoClient.box.SouthWest != sw {
+		t.Fatalf("unexpected geo box: %v", geoClient.box)
+	}
+	if !reflect.DeepEqual(res.HotelIds, []string{"2", "1"}) || res.Ranker != "distance" {
+		t.Fatalf("unexpected result: %v", res)
+	}
+}
+
+func TestCityJoinsProfileMatchesWithRates(t *testing.T) {
+	profileClient := &profileClientStub{res: &profile.CityResult{HotelIds: []string{"4", "5"}}}
+	s := &Search{
+		profileClient: profileClient,
+		rateClient: &rateClientStub{res: &rate.Result{RatePlans: []*rate.RatePlan{
+			{HotelId: "5"},
+		}}},
+	}
+
+	res, err := s.City(context.Background(), &searchpb.CityRequest{
+		City:    "San Francisco",
+		InDate:  "2015-04-09",
+		OutDate: "2015-04-10",
+	})
+	if err != nil {
+		t.Fatalf("City returned error: %v", err)
+	}
+	if profileClient.req.City != "San Francisco" {
+		t.Fatalf("profile city = %q, want San Francisco", profileClient.req.City)
+	}
+	if len(res.HotelIds) != 1 || res.HotelIds[0] != "5" {
+		t.Fatalf("unexpected hotel ids: %v", res.HotelIds)
+	}
+}
+
+func TestNearbyFiltersOnAmenities(t *testing.T) {
+	profileClient := &profileClientStub{profiles: &profile.Result{Hotels: []*profile.Hotel{
+		{Id: "1", Amenities: []string{"wifi", "pool"}},
+		{Id: "2", Amenities: []string{"wifi", "parking", "pool"}},
+		{Id: "3", Amenities: []string{"parking"}},
+	}}}
+	rateClient := &rateClientStub{res: &rate.Result{RatePlans: []*rate.RatePlan{
+		{HotelId: "1"},
+		{HotelId: "2"},
+		{HotelId: "3"},
+	}}}
+	s := &Search{
+		geoClient:     &geoClientStub{res: &geo.Result{HotelIds: []string{"3", "2", "1"}}},
+		rateClient:    rateClient,
+		profileClient: profileClient,
+	}
+
+	res, err := s.Nearby(context.Background(), &searchpb.NearbyRequest{
+		Lat:       37.7749,
+		Lon:       -122.4194,
+		InDate:    "2015-04-09",
+		OutDate:   "2015-04-10",
+		Amenities: []string{"parking", "pool"},
+	})
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	if !reflect.DeepEqual(res.HotelIds, []string{"2"}) {
+		t.Fatalf("hotel ids = %v, want [2]", res.HotelIds)
+	}
+	if got := profileClient.profilesReq.FieldMask.GetPaths(); !reflect.DeepEqual(got, []string{"amenities"}) {
+		t.Fatalf("profile field mask = %v, want [amenities]", got)
+	}
+	// hotels without the amenities are not quoted
+	if !reflect.DeepEqual(rateClient.req.HotelIds, []string{"2"}) {
+		t.Fatalf("rate hotel ids = %v, want [2]", rateClient.req.HotelIds)
+	}
+
+	profileClient.err = status.Error(codes.Unavailable, "down")
+	_, err = s.Nearby(context.Background(), &searchpb.NearbyRequest{
+		InDate:    "2015-04-09",
+		OutDate:   "2015-04-10",
+		Amenities: []string{"pool"},
+	})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected Unavailable, got %v", err)
+	}
+}
+
+func TestSearchRejectsUnknownAmenities(t *testing.T) {
+	profileClient := &profileClientStub{}
+	s := &Search{
+		geoClient:     &geoClientStub{res: &geo.Result{HotelIds: []string{"1"}}},
+		rateClient:    &rateClientStub{res: &rate.Result{}},
+		profileClient: profileClient,
+	}
+
+	want := `invalid amenities: unknown amenity "wify"; expected any of wifi, parking, pets, pool, gym, spa, restaurant, breakfast`
+	for name, search := range map[string]func() error{
+		"Nearby": func() error {
+			_, err := s.Nearby(context.Background(), &searchpb.NearbyRequest{InDate: "2015-04-09", OutDate: "2015-04-10", Amenities: []string{"wifi", "wify"}})
+			return err
+		},
+		"Within": func() error {
+			_, err := s.Within(context.Background(), &searchpb.WithinRequest{InDate: "2015-04-09", OutDate: "2015-04-10", Amenities: []string{"wify"}})
+			return err
+		},
+		"City": func() error {
+			_, err := s.City(context.Background(), &searchpb.CityRequest{City: "San Francisco", InDate: "2015-04-09", OutDate: "2015-04-10", Amenities: []string{"wify"}})
+			return err
+		},
+	} {
+		err := search()
+		if status.Code(err) != codes.InvalidArgument || status.Convert(err).Message() != want {
+			t.Fatalf("%s: error = %v, want InvalidArgument %q", name, err, want)
+		}
+	}
+	if profileClient.profilesReq != nil {
+		t.Fatalf("profiles were fetched for an invalid search")
+	}
+}
+
+func TestNearbyAppliesGeoBudgetAndCarriesLocation(t *testing.T) {
+	geoClient := &geoClientStub{res: &geo.Result{
+		HotelIds: []string{"1"},
+		Points:   []*geo.Point{{HotelId: "1", Lat: 37.7867, Lon: -122.4112}},
+	}}
+	s := &Search{
+		geoClient:  geoClient,
+		rateClient: &rateClientStub{res: &rate.Result{RatePlans: []*rate.RatePlan{{HotelId: "1"}}}},
+		timeouts:   Timeouts{Geo: time.Minute},
+	}
+
+	res, err := s.Nearby(context.Background(), &searchpb.NearbyRequest{})
+	if err != nil {
+		t.Fatalf("Nearby returned error: %v", err)
+	}
+	if geoClient.deadline.IsZero() || time.Until(geoClient.deadline) > time.Minute {
+		t.Fatalf("geo deadline = %v, want within a minute", geoClient.deadline)
+	}
+	if res.Hotels[0].Lat != 37.7867 || res.Hotels[0].Lon != -122.4112 {
+		t.Fatalf("unexpected hotel location: %v", res.Hotels[0])
+	}
+}
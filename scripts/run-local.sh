@@ -27,10 +27,11 @@ start_service() {
 }
 
 start_service geo -port=8081 -otel-endpoint=localhost:4317 geo
-start_service rate -port=8082 -otel-endpoint=localhost:4317 rate
+start_service reservation -port=8085 -otel-endpoint=localhost:4317 reservation
+start_service rate -port=8082 -reservationaddr=localhost:8085 -otel-endpoint=localhost:4317 rate
 start_service profile -port=8083 -otel-endpoint=localhost:4317 profile
-start_service search -port=8084 -geoaddr=localhost:8081 -rateaddr=localhost:8082 -otel-endpoint=localhost:4317 search
-start_service frontend -port=5001 -searchaddr=localhost:8084 -profileaddr=localhost:8083 -otel-endpoint=localhost:4317 frontend
+start_service search -port=8084 -geoaddr=localhost:8081 -rateaddr=localhost:8082 -profileaddr=localhost:8083 -otel-endpoint=localhost:4317 search
+start_service frontend -port=5001 -searchaddr=localhost:8084 -profileaddr=localhost:8083 -rateaddr=localhost:8082 -reservationaddr=localhost:8085 -otel-endpoint=localhost:4317 frontend
 
 echo
 echo "local stack is starting:"